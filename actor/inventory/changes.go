@@ -0,0 +1,42 @@
+package inventory
+
+import (
+	"context"
+	"log"
+
+	"github.com/alfreddobradi/actor-game/persistence"
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
+	"github.com/asynkron/protoactor-go/cluster"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newChangeProducer publishes to the topic of the inventory. Changes are only
+// notifications, so a batch that cannot be published is dropped instead of
+// stopping the producer.
+func newChangeProducer(ctx cluster.GrainContext) *cluster.BatchingProducer {
+	return ctx.Cluster().BatchingProducer(
+		shared.InventoryTopic(ctx.Identity()),
+		cluster.WithBatchingProducerOnPublishingError(func(retries int, err error, batch *cluster.PubSubBatch) *cluster.PublishingErrorDecision {
+			log.Printf("failed to publish %d changes of inventory %s: %v", len(batch.Envelopes), ctx.Identity(), err)
+			return cluster.FailBatchAndContinue
+		}),
+	)
+}
+
+// publish notifies the subscribers of the inventory about a recorded event.
+// Completed requests are bookkeeping and do not change what players see.
+// What accrues between events is not published.
+func (g *InventoryGrain) publish(e persistence.Event) {
+	if e.Type == EventRequestCompleted {
+		return
+	}
+
+	if _, err := g.changes.Produce(context.Background(), &shared.InventoryChanged{
+		Timestamp: timestamppb.New(e.Timestamp),
+		Sequence:  e.Sequence,
+		Event:     e.Type,
+		Data:      e.Data,
+	}); err != nil {
+		log.Printf("failed to publish event %d of inventory %s: %v", e.Sequence, g.ctx.Identity(), err)
+	}
+}
@@ -0,0 +1,247 @@
+package inventory
+
+import (
+	"log"
+	"sort"
+	"time"
+
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	StatusQueued     string = "queued"
+	StatusInProgress string = "in_progress"
+	StatusCompleted  string = "completed"
+)
+
+// Construction is a building or upgrade that has been paid for but is not
+// finished yet. A construction without StartedAt is waiting in the queue for
+// a free slot. Upgrades name the building they raise to Level.
+type Construction struct {
+	ID          string           `json:"id"`
+	Blueprint   string           `json:"blueprint"`
+	Building    string           `json:"building,omitempty"`
+	Level       int64            `json:"level,omitempty"`
+	Cost        map[string]int64 `json:"cost"`
+	Duration    time.Duration    `json:"duration"`
+	QueuedAt    time.Time        `json:"queued_at"`
+	StartedAt   time.Time        `json:"started_at"`
+	CompletesAt time.Time        `json:"completes_at"`
+}
+
+func (c Construction) Status() string {
+	if c.StartedAt.IsZero() {
+		return StatusQueued
+	}
+	return StatusInProgress
+}
+
+// inProgress returns the started constructions ordered by completion time
+func (g *InventoryGrain) inProgress() []Construction {
+	list := make([]Construction, 0, len(g.constructions))
+	for _, c := range g.constructions {
+		if c.Status() == StatusInProgress {
+			list = append(list, c)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].CompletesAt.Equal(list[j].CompletesAt) {
+			return list[i].ID < list[j].ID
+		}
+		return list[i].CompletesAt.Before(list[j].CompletesAt)
+	})
+	return list
+}
+
+// queued returns the constructions waiting for a slot in the order they were
+// requested
+func (g *InventoryGrain) queued() []Construction {
+	list := make([]Construction, 0, len(g.constructions))
+	for _, c := range g.constructions {
+		if c.Status() == StatusQueued {
+			list = append(list, c)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].QueuedAt.Equal(list[j].QueuedAt) {
+			return list[i].ID < list[j].ID
+		}
+		return list[i].QueuedAt.Before(list[j].QueuedAt)
+	})
+	return list
+}
+
+// advance finishes every construction whose build time has elapsed by now and
+// hands the freed slots to the queue. A slot is handed over at the moment it
+// freed up rather than at now, so the outcome does not depend on how late the
+// grain noticed. This runs on every access as well as when a timer fires so a
+// lost timer only delays the event, never the building itself. Production is
+// accrued up to now once the buildings are up to date.
+func (g *InventoryGrain) advance(now time.Time) {
+	for {
+		active := g.inProgress()
+		if len(active) > 0 && !active[0].CompletesAt.After(now) {
+			c := active[0]
+			var err error
+			if c.Building != "" {
+				err = g.emitAt(c.CompletesAt, EventBuildingUpgraded, BuildingUpgraded{Building: c.Building, Level: c.Level, Construction: c.ID})
+			} else {
+				err = g.emitAt(c.CompletesAt, EventBuildingConstructed, BuildingConstructed{Blueprint: c.Blueprint, Construction: c.ID})
+			}
+			if err != nil {
+				log.Printf("failed to complete construction %s for inventory %s: %v", c.ID, g.ctx.Identity(), err)
+				return
+			}
+			delete(g.armed, c.ID)
+
+			if _, err := g.startQueued(c.CompletesAt); err != nil {
+				return
+			}
+			continue
+		}
+
+		// constructions started now may already be due if they take no time
+		if started, err := g.startQueued(now); err != nil || started == 0 {
+			break
+		}
+	}
+
+	g.accrue(now)
+}
+
+// startQueued moves queued constructions into free slots, starting them at
+// the given time or when they were queued, whichever is later. It returns the
+// number of constructions started.
+func (g *InventoryGrain) startQueued(at time.Time) (int, error) {
+	started := 0
+	free := g.slots - len(g.inProgress())
+	for _, c := range g.queued() {
+		if free <= 0 {
+			break
+		}
+
+		start := at
+		if c.QueuedAt.After(start) {
+			start = c.QueuedAt
+		}
+		c.StartedAt = start
+		c.CompletesAt = start.Add(c.Duration)
+
+		if err := g.emitAt(start, EventConstructionStarted, ConstructionStarted{ID: c.ID, StartedAt: c.StartedAt, CompletesAt: c.CompletesAt}); err != nil {
+			log.Printf("failed to start construction %s for inventory %s: %v", c.ID, g.ctx.Identity(), err)
+			return started, err
+		}
+		free--
+		started++
+
+		if c.CompletesAt.After(time.Now()) {
+			g.startTimer(c)
+		}
+	}
+
+	return started, nil
+}
+
+// refund returns the resources given back when the construction is cancelled
+func (c Construction) refund(percent int64) map[string]int64 {
+	if c.Status() == StatusQueued {
+		percent = 100
+	}
+
+	amount := make(map[string]int64, len(c.Cost))
+	for k, v := range c.Cost {
+		amount[k] = v * percent / 100
+	}
+	return amount
+}
+
+// schedule returns every construction with the time it is expected to
+// finish. Queued constructions are assigned to slots in order as they free up.
+func (g *InventoryGrain) schedule(now time.Time) []Construction {
+	active := g.inProgress()
+	list := append(make([]Construction, 0, len(g.constructions)), active...)
+
+	free := make([]time.Time, 0, g.slots)
+	for _, c := range active {
+		free = append(free, c.CompletesAt)
+	}
+	for len(free) < g.slots {
+		free = append(free, now)
+	}
+	sort.Slice(free, func(i, j int) bool { return free[i].Before(free[j]) })
+
+	for _, c := range g.queued() {
+		start := free[0]
+		if start.Before(now) {
+			start = now
+		}
+		c.CompletesAt = start.Add(c.Duration)
+		list = append(list, c)
+
+		free[0] = c.CompletesAt
+		sort.Slice(free, func(i, j int) bool { return free[i].Before(free[j]) })
+	}
+
+	return list
+}
+
+// startTimer asks the Timer grain to notify this inventory once the
+// construction is due
+func (g *InventoryGrain) startTimer(c Construction) {
+	if g.armed[c.ID] {
+		return
+	}
+
+	delay := time.Until(c.CompletesAt)
+	if delay < 0 {
+		delay = 0
+	}
+
+	client := shared.GetTimerGrainClient(g.ctx.Cluster(), g.ctx.Identity())
+	_, err := client.Start(&shared.StartTimerRequest{
+		Timestamp: timestamppb.Now(),
+		Kind:      g.ctx.Kind(),
+		Identity:  g.ctx.Identity(),
+		Delay:     durationpb.New(delay),
+		Payload:   []byte(c.ID),
+	})
+	if err != nil {
+		log.Printf("failed to start timer for construction %s in inventory %s: %v", c.ID, g.ctx.Identity(), err)
+		return
+	}
+	g.armed[c.ID] = true
+}
+
+// scheduled returns the message for a construction as it currently stands.
+// Constructions that already finished are reported as completed.
+func (g *InventoryGrain) scheduled(construction Construction, now time.Time) *shared.Construction {
+	for _, c := range g.schedule(now) {
+		if c.ID == construction.ID {
+			return constructionMessage(c)
+		}
+	}
+
+	msg := constructionMessage(construction)
+	msg.Status = StatusCompleted
+	return msg
+}
+
+func constructionMessage(c Construction) *shared.Construction {
+	msg := &shared.Construction{
+		ID:        c.ID,
+		Blueprint: c.Blueprint,
+		Status:    c.Status(),
+		QueuedAt:  timestamppb.New(c.QueuedAt),
+		Building:  c.Building,
+		Level:     c.Level,
+	}
+	if !c.StartedAt.IsZero() {
+		msg.StartedAt = timestamppb.New(c.StartedAt)
+	}
+	if !c.CompletesAt.IsZero() {
+		msg.CompletesAt = timestamppb.New(c.CompletesAt)
+	}
+	return msg
+}
@@ -0,0 +1,55 @@
+package inventory
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRefund(t *testing.T) {
+	at := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		cost    map[string]int64
+		started bool
+		percent int64
+		want    map[string]int64
+	}{
+		{"queued", map[string]int64{"wood": 30}, false, 50, map[string]int64{"wood": 30}},
+		{"started", map[string]int64{"wood": 30}, true, 50, map[string]int64{"wood": 15}},
+		{"rounded down", map[string]int64{"wood": 5, "stone": 3}, true, 50, map[string]int64{"wood": 2, "stone": 1}},
+		{"free", map[string]int64{}, false, 100, map[string]int64{}},
+	}
+
+	for _, tt := range tests {
+		// constructions are refunded as they were recorded
+		g := newTestGrain()
+		queued := Construction{ID: "c1", Blueprint: "house", Cost: tt.cost, QueuedAt: at}
+		if err := g.apply(event(t, 1, at, EventConstructionQueued, queued)); err != nil {
+			t.Fatal(err)
+		}
+		if tt.started {
+			started := ConstructionStarted{ID: "c1", StartedAt: at, CompletesAt: at.Add(time.Hour)}
+			if err := g.apply(event(t, 2, at, EventConstructionStarted, started)); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// a cost of nothing stays recorded so it is not mistaken for a
+		// missing one
+		if g.constructions["c1"].Cost == nil {
+			t.Errorf("%s: want the cost to be recorded", tt.name)
+		}
+
+		got := g.constructions["c1"].refund(tt.percent)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: want %v, got %v", tt.name, tt.want, got)
+			continue
+		}
+		for resource, amount := range tt.want {
+			if got[resource] != amount {
+				t.Errorf("%s: want %v, got %v", tt.name, tt.want, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package inventory
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/alfreddobradi/actor-game/registry"
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
+	"github.com/asynkron/protoactor-go/cluster"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// dependents returns the names of the blueprints whose requirements would no
+// longer be met by the player's buildings if one building of the given
+// blueprint was gone
+func (g *InventoryGrain) dependents(blueprint string) []string {
+	owned := g.buildings.Counts()
+	for _, c := range g.constructions {
+		if c.Building == "" {
+			owned[c.Blueprint]++
+		}
+	}
+
+	remaining := owned[blueprint] - 1
+	dependents := make([]string, 0)
+	for _, candidate := range registry.List() {
+		if owned[candidate.ID] == 0 {
+			continue
+		}
+		for _, requirement := range candidate.Requirements {
+			if requirement.Blueprint == blueprint && remaining < requirement.Count {
+				dependents = append(dependents, candidate.Name)
+			}
+		}
+	}
+	return dependents
+}
+
+// salvage returns the resources given back when the building is demolished,
+// which is a share of what was spent building it and upgrading it to its
+// current level
+func salvage(building Building, blueprint registry.Blueprint) map[string]int64 {
+	spent := make(map[string]int64)
+	for number := int64(1); number <= building.Level; number++ {
+		level, ok := blueprint.Level(number)
+		if !ok {
+			break
+		}
+		for k, v := range level.Cost {
+			spent[k] += v
+		}
+	}
+
+	amount := make(map[string]int64, len(spent))
+	for k, v := range spent {
+		amount[k] = v * blueprint.SalvagePercent / 100
+	}
+	return amount
+}
+
+func (g *InventoryGrain) Demolish(req *shared.DemolishRequest, ctx cluster.GrainContext) (*shared.DemolishResponse, error) {
+	g.advance(time.Now())
+
+	id := req.GetBuilding()
+	building, ok := g.buildings.Get(id)
+	if !ok {
+		return demolishError(shared.Failure(shared.ErrorCode_BuildingNotFound, "building not found")), nil
+	}
+
+	blueprint, err := registry.Lookup(building.Blueprint)
+	if err != nil {
+		return demolishError(shared.Failure(shared.ErrorCode_BlueprintNotFound, "requested blueprint not found")), nil
+	}
+
+	if g.upgrading(building.ID) {
+		return demolishError(shared.Failure(shared.ErrorCode_UpgradeInProgress, "building is being upgraded")), nil
+	}
+
+	if dependents := g.dependents(blueprint.ID); len(dependents) > 0 {
+		detail := shared.Failure(shared.ErrorCode_BuildingRequired, fmt.Sprintf("required by %s", strings.Join(dependents, ", ")))
+		detail.Reasons = dependents
+		return demolishError(detail), nil
+	}
+
+	amount := salvage(building, blueprint)
+
+	if err := g.emit(EventBuildingDemolished, BuildingDemolished{Building: building.ID}); err != nil {
+		log.Printf("failed to record demolition for inventory %s: %v", ctx.Identity(), err)
+		return demolishError(shared.Failure(shared.ErrorCode_Internal, "failed to save inventory")), nil
+	}
+
+	if err := g.emit(EventResourcesRefunded, ResourcesRefunded{Blueprint: blueprint.ID, Amount: amount}); err != nil {
+		log.Printf("failed to salvage building %s for inventory %s: %v", building.ID, ctx.Identity(), err)
+	}
+
+	return &shared.DemolishResponse{
+		Timestamp: timestamppb.Now(),
+		Status:    shared.Status_OK,
+		Building:  building.ID,
+		Salvage:   resourceAmounts(amount),
+	}, nil
+}
+
+func demolishError(detail *shared.ErrorDetail) *shared.DemolishResponse {
+	return &shared.DemolishResponse{
+		Timestamp: timestamppb.Now(),
+		Status:    shared.Status_Error,
+		Error:     detail,
+	}
+}
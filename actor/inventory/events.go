@@ -0,0 +1,172 @@
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/alfreddobradi/actor-game/persistence"
+)
+
+const (
+	EventResourcesReserved     string = "resources_reserved"
+	EventResourcesRefunded     string = "resources_refunded"
+	EventConstructionQueued    string = "construction_queued"
+	EventConstructionStarted   string = "construction_started"
+	EventConstructionCancelled string = "construction_cancelled"
+	EventBuildingConstructed   string = "building_constructed"
+	EventBuildingUpgraded      string = "building_upgraded"
+	EventBuildingDemolished    string = "building_demolished"
+	EventWorkersAssigned       string = "workers_assigned"
+	EventRequestCompleted      string = "request_completed"
+
+	// snapshotInterval is the number of events after which the grain writes
+	// a new snapshot so replays on activation stay short.
+	snapshotInterval uint64 = 50
+)
+
+type ResourcesReserved struct {
+	Blueprint string           `json:"blueprint"`
+	Cost      map[string]int64 `json:"cost"`
+}
+
+type ResourcesRefunded struct {
+	Blueprint string           `json:"blueprint"`
+	Amount    map[string]int64 `json:"amount"`
+}
+
+type ConstructionStarted struct {
+	ID          string    `json:"id"`
+	StartedAt   time.Time `json:"started_at"`
+	CompletesAt time.Time `json:"completes_at"`
+}
+
+type ConstructionCancelled struct {
+	ID string `json:"id"`
+}
+
+type BuildingConstructed struct {
+	Blueprint    string `json:"blueprint"`
+	Construction string `json:"construction"`
+}
+
+type BuildingUpgraded struct {
+	Building     string `json:"building"`
+	Level        int64  `json:"level"`
+	Construction string `json:"construction"`
+}
+
+type BuildingDemolished struct {
+	Building string `json:"building"`
+}
+
+type WorkersAssigned struct {
+	Blueprint string `json:"blueprint"`
+	Workers   int64  `json:"workers"`
+}
+
+type RequestCompleted struct {
+	Key      string `json:"key"`
+	Request  string `json:"request"`
+	Response []byte `json:"response"`
+}
+
+// apply mutates the inventory according to a single event. It is used both
+// for live mutations and for replaying the event log on activation, so it
+// must not perform any validation that could fail for a recorded event.
+func (g *InventoryGrain) apply(e persistence.Event) error {
+	// production and upkeep up to the event are accrued with the buildings
+	// that existed before it. Replaying the log accrues at the same times,
+	// which makes it yield the same resources and active buildings.
+	g.accrue(e.Timestamp)
+
+	switch e.Type {
+	case EventResourcesReserved:
+		var data ResourcesReserved
+		if err := json.Unmarshal(e.Data, &data); err != nil {
+			return err
+		}
+		// the cost was checked when it was recorded and is taken even if
+		// the resources have changed since
+		g.resources.Withdraw(data.Cost)
+	case EventResourcesRefunded:
+		var data ResourcesRefunded
+		if err := json.Unmarshal(e.Data, &data); err != nil {
+			return err
+		}
+		g.resources.Deposit(data.Amount)
+	case EventConstructionQueued:
+		var data Construction
+		if err := json.Unmarshal(e.Data, &data); err != nil {
+			return err
+		}
+		g.constructions[data.ID] = data
+	case EventConstructionStarted:
+		var data ConstructionStarted
+		if err := json.Unmarshal(e.Data, &data); err != nil {
+			return err
+		}
+		c, ok := g.constructions[data.ID]
+		if !ok {
+			return fmt.Errorf("construction %s not found", data.ID)
+		}
+		c.StartedAt = data.StartedAt
+		c.CompletesAt = data.CompletesAt
+		g.constructions[data.ID] = c
+	case EventConstructionCancelled:
+		var data ConstructionCancelled
+		if err := json.Unmarshal(e.Data, &data); err != nil {
+			return err
+		}
+		delete(g.constructions, data.ID)
+	case EventBuildingConstructed:
+		var data BuildingConstructed
+		if err := json.Unmarshal(e.Data, &data); err != nil {
+			return err
+		}
+		delete(g.constructions, data.Construction)
+		g.buildings.Build(data.Construction, data.Blueprint)
+	case EventBuildingUpgraded:
+		var data BuildingUpgraded
+		if err := json.Unmarshal(e.Data, &data); err != nil {
+			return err
+		}
+		delete(g.constructions, data.Construction)
+		if !g.buildings.Upgrade(data.Building, data.Level) {
+			return fmt.Errorf("building %s not found", data.Building)
+		}
+	case EventBuildingDemolished:
+		var data BuildingDemolished
+		if err := json.Unmarshal(e.Data, &data); err != nil {
+			return err
+		}
+		building, ok := g.buildings.Get(data.Building)
+		if !ok {
+			return fmt.Errorf("building %s not found", data.Building)
+		}
+		g.buildings.Remove(building.ID)
+		delete(g.owed, building.ID)
+		// people lose the jobs and homes the building provided
+		if jobs := g.jobs(building.Blueprint); g.assignments[building.Blueprint] > jobs {
+			g.assignments[building.Blueprint] = jobs
+		}
+		g.leave(g.housing())
+	case EventWorkersAssigned:
+		var data WorkersAssigned
+		if err := json.Unmarshal(e.Data, &data); err != nil {
+			return err
+		}
+		g.assignments[data.Blueprint] = data.Workers
+	case EventRequestCompleted:
+		var data RequestCompleted
+		if err := json.Unmarshal(e.Data, &data); err != nil {
+			return err
+		}
+		g.forget(e.Timestamp)
+		g.requests[data.Key] = completedRequest{Request: data.Request, Response: data.Response, At: e.Timestamp}
+	default:
+		return fmt.Errorf("unknown event type %s", e.Type)
+	}
+
+	return nil
+}
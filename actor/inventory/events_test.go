@@ -0,0 +1,45 @@
+package inventory
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/alfreddobradi/actor-game/persistence"
+)
+
+func event(t *testing.T, sequence uint64, at time.Time, eventType string, data interface{}) persistence.Event {
+	t.Helper()
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return persistence.Event{Sequence: sequence, Type: eventType, Timestamp: at, Data: raw}
+}
+
+func TestApplyReplaysWithoutValidating(t *testing.T) {
+	at := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	g := newTestGrain()
+	g.resources.store["wood"] = 10
+
+	events := []persistence.Event{
+		// recorded when the player could afford it
+		event(t, 1, at, EventResourcesReserved, ResourcesReserved{Blueprint: "house", Cost: map[string]int64{"wood": 30}}),
+		// the blueprint was removed from the catalog since
+		event(t, 2, at, EventBuildingConstructed, BuildingConstructed{Blueprint: "retired", Construction: "c1"}),
+	}
+	for _, e := range events {
+		if err := g.apply(e); err != nil {
+			t.Fatalf("event %d: %v", e.Sequence, err)
+		}
+	}
+
+	if wood := g.resources.Amount("wood"); wood != -20 {
+		t.Errorf("want the recorded cost taken, leaving -20 wood, got %d", wood)
+	}
+	if building, ok := g.buildings.Get("c1"); !ok || building.Blueprint != "retired" {
+		t.Errorf("want the building of the unknown blueprint kept, got %+v", building)
+	}
+}
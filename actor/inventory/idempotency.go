@@ -0,0 +1,71 @@
+package inventory
+
+import (
+	"log"
+	"time"
+
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// idempotencyWindow is how long the response to a request with an
+// idempotency key is remembered
+const idempotencyWindow = 24 * time.Hour
+
+// completedRequest is the response given to a request with an idempotency
+// key. Request describes what was asked for so a key cannot be reused for a
+// different request.
+type completedRequest struct {
+	Request  string    `json:"request"`
+	Response []byte    `json:"response"`
+	At       time.Time `json:"at"`
+}
+
+// replay returns the response recorded for the idempotency key, if any
+func (g *InventoryGrain) replay(key, request string) (*shared.BuildResponse, bool) {
+	if key == "" {
+		return nil, false
+	}
+
+	completed, ok := g.requests[key]
+	if !ok || time.Since(completed.At) > idempotencyWindow {
+		return nil, false
+	}
+	if completed.Request != request {
+		return errorResponse(shared.Failure(shared.ErrorCode_IdempotencyKeyReused, "idempotency key was used for a different request")), true
+	}
+
+	res := &shared.BuildResponse{}
+	if err := proto.Unmarshal(completed.Response, res); err != nil {
+		log.Printf("failed to decode response to request %s for inventory %s: %v", key, g.ctx.Identity(), err)
+		return nil, false
+	}
+	return res, true
+}
+
+// remember records the response to a successful request with an idempotency
+// key. A failure to record it only loses the protection against retries.
+func (g *InventoryGrain) remember(key, request string, res *shared.BuildResponse) {
+	if key == "" {
+		return
+	}
+
+	data, err := proto.Marshal(res)
+	if err != nil {
+		log.Printf("failed to encode response to request %s for inventory %s: %v", key, g.ctx.Identity(), err)
+		return
+	}
+
+	if err := g.emit(EventRequestCompleted, RequestCompleted{Key: key, Request: request, Response: data}); err != nil {
+		log.Printf("failed to record request %s for inventory %s: %v", key, g.ctx.Identity(), err)
+	}
+}
+
+// forget drops the responses that are older than the idempotency window
+func (g *InventoryGrain) forget(now time.Time) {
+	for key, completed := range g.requests {
+		if now.Sub(completed.At) > idempotencyWindow {
+			delete(g.requests, key)
+		}
+	}
+}
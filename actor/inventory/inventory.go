@@ -1,22 +1,25 @@
 package inventory
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/alfreddobradi/actor-game/persistence"
 	"github.com/alfreddobradi/actor-game/registry"
-	"github.com/alfreddobradi/actor-game/shared"
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
 	"github.com/asynkron/protoactor-go/cluster"
-	"google.golang.org/protobuf/types/known/structpb"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
-	KeyBlueprint string = "blueprint"
-
-	KeyPopulation string = "population"
-	KeyResources  string = "resources"
-	KeyBuildings  string = "buildings"
+	persistenceKind string = "inventory"
 )
 
 type ResourceStore struct {
@@ -27,155 +30,675 @@ type ResourceStore struct {
 
 type RollbackFn func()
 
-func (r *ResourceStore) Reserve(cost map[string]int64) (RollbackFn, error) {
+// Check reports whether the store holds at least the given amount of every
+// resource in cost without modifying it.
+func (r *ResourceStore) Check(cost map[string]int64) error {
 	r.mx.Lock()
 	defer r.mx.Unlock()
 
+	return r.check(cost)
+}
+
+func (r *ResourceStore) check(cost map[string]int64) error {
 	for k, v := range cost {
 		if actual, ok := r.store[k]; !ok || actual < v {
-			return nil, fmt.Errorf("not enough %s", k)
+			return fmt.Errorf("not enough %s", k)
+		}
+	}
+
+	return nil
+}
+
+// Missing returns how much of each resource in cost the store lacks
+func (r *ResourceStore) Missing(cost map[string]int64) map[string]int64 {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	missing := make(map[string]int64)
+	for k, v := range cost {
+		if actual := r.store[k]; actual < v {
+			missing[k] = v - actual
 		}
 	}
+	return missing
+}
+
+func (r *ResourceStore) Reserve(cost map[string]int64) (RollbackFn, error) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if err := r.check(cost); err != nil {
+		return nil, err
+	}
 
 	for k, v := range cost {
 		r.store[k] -= v
 	}
 
 	rollback := func() {
-		r.mx.Lock()
-		defer r.mx.Unlock()
+		r.Deposit(cost)
+	}
 
-		for k, v := range cost {
-			r.store[k] += v
+	return rollback, nil
+}
+
+// Take removes up to amount of the resource from the store and returns how
+// much it removed
+func (r *ResourceStore) Take(resource string, amount int64) int64 {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if held := r.store[resource]; amount > held {
+		amount = held
+	}
+	if amount <= 0 {
+		return 0
+	}
+	r.store[resource] -= amount
+	return amount
+}
+
+// Withdraw removes amount from the store. Unlike Reserve it does not check
+// that the store holds enough, so a resource can drop below zero.
+func (r *ResourceStore) Withdraw(amount map[string]int64) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	for k, v := range amount {
+		r.store[k] -= v
+	}
+}
+
+// Amount returns how much of the resource the store holds
+func (r *ResourceStore) Amount(resource string) int64 {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	return r.store[resource]
+}
+
+func (r *ResourceStore) Deposit(amount map[string]int64) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	for k, v := range amount {
+		r.store[k] += v
+	}
+}
+
+// Produce adds amount to the store without raising any resource above its
+// capacity. Resources already over capacity are left as they are.
+func (r *ResourceStore) Produce(amount, capacity map[string]int64) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	for k, v := range amount {
+		if limit, ok := capacity[k]; ok && r.store[k]+v > limit {
+			v = limit - r.store[k]
+			if v < 0 {
+				v = 0
+			}
 		}
+		r.store[k] += v
 	}
+}
 
-	return rollback, nil
+// Building is a finished building. Its ID is the ID of the construction that
+// built it.
+type Building struct {
+	ID        string `json:"id"`
+	Blueprint string `json:"blueprint"`
+	Level     int64  `json:"level"`
 }
 
 type BuildingStore struct {
 	mx *sync.Mutex
 
-	store map[string]int64
+	store map[string]Building
 }
 
-func (b *BuildingStore) Build(bp registry.Blueprint) {
+// Build adds a building of the given blueprint at its first level
+func (b *BuildingStore) Build(id, blueprint string) {
 	b.mx.Lock()
 	defer b.mx.Unlock()
-	if _, ok := b.store[bp.Name]; !ok {
-		b.store[bp.Name] = 1
-	} else {
-		b.store[bp.Name] += 1
+	b.store[id] = Building{ID: id, Blueprint: blueprint, Level: 1}
+}
+
+// Upgrade sets the level of a building. It reports false if there is no
+// building with the given ID.
+func (b *BuildingStore) Upgrade(id string, level int64) bool {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	building, ok := b.store[id]
+	if !ok {
+		return false
+	}
+	building.Level = level
+	b.store[id] = building
+	return true
+}
+
+// Remove deletes a building, reporting false if there is no building with
+// the given ID
+func (b *BuildingStore) Remove(id string) bool {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	if _, ok := b.store[id]; !ok {
+		return false
+	}
+	delete(b.store, id)
+	return true
+}
+
+func (b *BuildingStore) Get(id string) (Building, bool) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	building, ok := b.store[id]
+	return building, ok
+}
+
+// List returns every building ordered by blueprint and ID
+func (b *BuildingStore) List() []Building {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	list := make([]Building, 0, len(b.store))
+	for _, building := range b.store {
+		list = append(list, building)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Blueprint == list[j].Blueprint {
+			return list[i].ID < list[j].ID
+		}
+		return list[i].Blueprint < list[j].Blueprint
+	})
+	return list
+}
+
+// Config holds the settings shared by every inventory grain
+type Config struct {
+	// BuildSlots is the number of constructions a player can have in
+	// progress at the same time. Further constructions wait in a queue.
+	BuildSlots int
+}
+
+// Count returns the number of buildings of the given blueprint
+func (b *BuildingStore) Count(blueprint string) int64 {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	var count int64
+	for _, building := range b.store {
+		if building.Blueprint == blueprint {
+			count++
+		}
+	}
+	return count
+}
+
+// Counts returns the number of buildings of each blueprint
+func (b *BuildingStore) Counts() map[string]int64 {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	counts := make(map[string]int64)
+	for _, building := range b.store {
+		counts[building.Blueprint]++
 	}
+	return counts
 }
 
 type InventoryGrain struct {
-	ctx cluster.GrainContext
+	ctx     cluster.GrainContext
+	backend persistence.Backend
+	slots   int
+
+	// changes publishes the recorded events to subscribers of the inventory
+	changes *cluster.BatchingProducer
+
+	sequence   uint64
+	snapshotAt uint64
+
+	population    int64
+	assignments   map[string]int64
+	resources     *ResourceStore
+	buildings     *BuildingStore
+	constructions map[string]Construction
+	armed         map[string]bool
+
+	// producedAt is the time production was last accrued up to and carry
+	// holds what was produced since then but does not add up to a whole unit
+	producedAt      time.Time
+	carry           map[string]int64
+	populationCarry int64
+
+	// owed holds the upkeep each building has not paid yet, counted in
+	// production units like carry, and upkeepCarry what the population
+	// consumed towards the next whole unit. hungerCarry is what counts
+	// towards the next person leaving while they go hungry.
+	owed        map[string]map[string]int64
+	upkeepCarry map[string]int64
+	hungerCarry int64
+
+	// requests holds the responses to recent requests with an idempotency
+	// key
+	requests map[string]completedRequest
+}
 
-	population int64
-	resources  *ResourceStore
-	buildings  *BuildingStore
+// snapshot is the serialized form of an inventory as written to the backend.
+// Sequence is the last event already reflected in the snapshot.
+type snapshot struct {
+	Sequence        uint64                      `json:"sequence"`
+	Population      int64                       `json:"population"`
+	Resources       map[string]int64            `json:"resources"`
+	Buildings       map[string]Building         `json:"buildings"`
+	Constructions   map[string]Construction     `json:"constructions"`
+	Assignments     map[string]int64            `json:"assignments"`
+	ProducedAt      time.Time                   `json:"produced_at"`
+	Carry           map[string]int64            `json:"carry"`
+	PopulationCarry int64                       `json:"population_carry"`
+	UpkeepCarry     map[string]int64            `json:"upkeep_carry"`
+	HungerCarry     int64                       `json:"hunger_carry,omitempty"`
+	Owed            map[string]map[string]int64 `json:"upkeep_owed,omitempty"`
+	Requests        map[string]completedRequest `json:"requests,omitempty"`
+}
+
+func New(backend persistence.Backend, config Config) *InventoryGrain {
+	slots := config.BuildSlots
+	if slots < 1 {
+		slots = 1
+	}
+	return &InventoryGrain{backend: backend, slots: slots}
 }
 
 func (g *InventoryGrain) Init(ctx cluster.GrainContext) {
 	g.ctx = ctx
 
 	g.population = 100
+	g.assignments = make(map[string]int64)
 	g.resources = &ResourceStore{
-		mx: &sync.Mutex{},
-		store: map[string]int64{
-			shared.ResourceWood: 100,
-		},
+		mx:    &sync.Mutex{},
+		store: make(map[string]int64),
+	}
+	for _, resource := range registry.Resources() {
+		g.resources.store[resource.ID] = resource.Starting
 	}
 	g.buildings = &BuildingStore{
 		mx:    &sync.Mutex{},
-		store: make(map[string]int64),
+		store: make(map[string]Building),
+	}
+	g.constructions = make(map[string]Construction)
+	g.armed = make(map[string]bool)
+	g.carry = make(map[string]int64)
+	g.owed = make(map[string]map[string]int64)
+	g.upkeepCarry = make(map[string]int64)
+	g.requests = make(map[string]completedRequest)
+
+	if err := g.load(); err != nil {
+		log.Printf("failed to load inventory %s: %v", ctx.Identity(), err)
+	}
+
+	g.changes = newChangeProducer(ctx)
+
+	// timers do not survive restarts so running constructions are re-armed
+	g.advance(time.Now())
+	for _, c := range g.inProgress() {
+		g.startTimer(c)
 	}
 }
 
-func (g *InventoryGrain) Terminate(ctx cluster.GrainContext)      {}
-func (g *InventoryGrain) ReceiveDefault(ctx cluster.GrainContext) {}
+func (g *InventoryGrain) Terminate(ctx cluster.GrainContext) {
+	g.changes.Dispose()
+
+	if g.sequence == g.snapshotAt {
+		return
+	}
+	if err := g.snapshot(); err != nil {
+		log.Printf("failed to snapshot inventory %s: %v", ctx.Identity(), err)
+	}
+}
+
+func (g *InventoryGrain) ReceiveDefault(ctx cluster.GrainContext) {
+	switch ctx.Message().(type) {
+	case *shared.TimerFired:
+		g.advance(time.Now())
+	}
+}
+
+// load restores the latest snapshot and replays every event recorded after it
+func (g *InventoryGrain) load() error {
+	if g.backend == nil {
+		return nil
+	}
+
+	data, err := g.backend.Load(persistenceKind, g.ctx.Identity())
+	if err != nil && !errors.Is(err, persistence.ErrNotFound) {
+		return err
+	}
+
+	if err == nil {
+		var snap snapshot
+		if err := json.Unmarshal(data, &snap); err != nil {
+			return err
+		}
+
+		g.sequence = snap.Sequence
+		g.snapshotAt = snap.Sequence
+		g.population = snap.Population
+		if snap.Resources != nil {
+			g.resources.store = snap.Resources
+		}
+		if snap.Buildings != nil {
+			g.buildings.store = snap.Buildings
+		}
+		if snap.Constructions != nil {
+			g.constructions = snap.Constructions
+		}
+		if snap.Assignments != nil {
+			g.assignments = snap.Assignments
+		}
+		g.producedAt = snap.ProducedAt
+		g.populationCarry = snap.PopulationCarry
+		if snap.UpkeepCarry != nil {
+			g.upkeepCarry = snap.UpkeepCarry
+		}
+		g.hungerCarry = snap.HungerCarry
+		if snap.Owed != nil {
+			g.owed = snap.Owed
+		}
+		if snap.Carry != nil {
+			g.carry = snap.Carry
+		}
+		if snap.Requests != nil {
+			g.requests = snap.Requests
+		}
+	}
+
+	events, err := g.backend.Events(persistenceKind, g.ctx.Identity(), g.sequence)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range events {
+		if err := g.apply(e); err != nil {
+			log.Printf("failed to replay event %d (%s) for inventory %s: %v", e.Sequence, e.Type, g.ctx.Identity(), err)
+		}
+		g.sequence = e.Sequence
+	}
+
+	return nil
+}
+
+func (g *InventoryGrain) snapshot() error {
+	if g.backend == nil {
+		return nil
+	}
+
+	g.resources.mx.Lock()
+	g.buildings.mx.Lock()
+	data, err := json.Marshal(snapshot{
+		Sequence:        g.sequence,
+		Population:      g.population,
+		Resources:       g.resources.store,
+		Buildings:       g.buildings.store,
+		Constructions:   g.constructions,
+		Assignments:     g.assignments,
+		ProducedAt:      g.producedAt,
+		Carry:           g.carry,
+		PopulationCarry: g.populationCarry,
+		UpkeepCarry:     g.upkeepCarry,
+		HungerCarry:     g.hungerCarry,
+		Owed:            g.owed,
+		Requests:        g.requests,
+	})
+	g.buildings.mx.Unlock()
+	g.resources.mx.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := g.backend.Save(persistenceKind, g.ctx.Identity(), data); err != nil {
+		return err
+	}
+	g.snapshotAt = g.sequence
+
+	return nil
+}
+
+// emit appends an event to the log and applies it to the in-memory state.
+// Nothing is applied if the event could not be recorded.
+func (g *InventoryGrain) emit(eventType string, payload interface{}) error {
+	return g.emitAt(time.Now(), eventType, payload)
+}
+
+// emitAt is emit for events that took effect at an earlier time than they
+// were recorded, such as constructions the grain noticed finishing late
+func (g *InventoryGrain) emitAt(at time.Time, eventType string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	e := persistence.Event{
+		Sequence:  g.sequence + 1,
+		Type:      eventType,
+		Timestamp: at.UTC(),
+		Data:      data,
+	}
+
+	if g.backend != nil {
+		if err := g.backend.Append(persistenceKind, g.ctx.Identity(), e); err != nil {
+			return err
+		}
+	}
+
+	g.sequence = e.Sequence
+	if err := g.apply(e); err != nil {
+		return err
+	}
+	g.publish(e)
+
+	if g.sequence-g.snapshotAt >= snapshotInterval {
+		if err := g.snapshot(); err != nil {
+			log.Printf("failed to snapshot inventory %s: %v", g.ctx.Identity(), err)
+		}
+	}
+
+	return nil
+}
+
+// errorResponse reports a build or upgrade the inventory refused
+func errorResponse(detail *shared.ErrorDetail) *shared.BuildResponse {
+	return &shared.BuildResponse{
+		Timestamp: timestamppb.Now(),
+		Status:    shared.Status_Error,
+		Error:     detail,
+	}
+}
+
+// insufficient describes the resources lacking to pay for something
+func insufficient(missing map[string]int64) *shared.ErrorDetail {
+	names := make([]string, 0, len(missing))
+	for k := range missing {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	detail := shared.Failure(shared.ErrorCode_InsufficientResources, fmt.Sprintf("not enough %s", strings.Join(names, ", ")))
+	detail.Missing = resourceAmounts(missing)
+	return detail
+}
 
 func (g *InventoryGrain) StartBuild(req *shared.BuildRequest, ctx cluster.GrainContext) (*shared.BuildResponse, error) {
-	bppb, ok := req.Context.Fields[KeyBlueprint]
-	if !ok {
-		return &shared.BuildResponse{
-			Timestamp: timestamppb.Now(),
-			Status:    shared.Status_Error,
-			Context: &structpb.Struct{
-				Fields: map[string]*structpb.Value{
-					shared.KeyError: structpb.NewStringValue("requested blueprint not found"),
-				},
-			},
-		}, nil
+	g.advance(time.Now())
+
+	request := "build " + req.GetBlueprint()
+	if res, ok := g.replay(req.GetIdempotencyKey(), request); ok {
+		return res, nil
 	}
 
-	blueprintName := bppb.GetStringValue()
-	blueprint, err := registry.GetBlueprint(blueprintName)
+	blueprint, err := registry.Lookup(req.GetBlueprint())
 	if err != nil {
-		return &shared.BuildResponse{
+		return errorResponse(shared.Failure(shared.ErrorCode_BlueprintNotFound, "requested blueprint not found")), nil
+	}
+
+	if reasons := g.unmet(blueprint); len(reasons) > 0 {
+		detail := shared.Failure(shared.ErrorCode_RequirementUnmet, fmt.Sprintf("requirements not met: %s", strings.Join(reasons, ", ")))
+		detail.Reasons = reasons
+		return errorResponse(detail), nil
+	}
+
+	duration, err := blueprint.Duration()
+	if err != nil {
+		log.Printf("invalid build time for blueprint %s: %v", blueprint.ID, err)
+		return errorResponse(shared.Failure(shared.ErrorCode_Internal, "invalid blueprint build time")), nil
+	}
+
+	if missing := g.resources.Missing(blueprint.Cost); len(missing) > 0 {
+		return errorResponse(insufficient(missing)), nil
+	}
+
+	if err := g.emit(EventResourcesReserved, ResourcesReserved{Blueprint: blueprint.ID, Cost: blueprint.Cost}); err != nil {
+		log.Printf("failed to record reservation for inventory %s: %v", ctx.Identity(), err)
+		return errorResponse(shared.Failure(shared.ErrorCode_Internal, "failed to save inventory")), nil
+	}
+
+	now := time.Now().UTC()
+	construction := Construction{
+		ID:        uuid.NewString(),
+		Blueprint: blueprint.ID,
+		Cost:      blueprint.Cost,
+		Duration:  duration,
+		QueuedAt:  now,
+	}
+
+	if err := g.emit(EventConstructionQueued, construction); err != nil {
+		log.Printf("failed to record construction for inventory %s: %v", ctx.Identity(), err)
+		if err := g.emit(EventResourcesRefunded, ResourcesRefunded{Blueprint: blueprint.ID, Amount: blueprint.Cost}); err != nil {
+			log.Printf("failed to roll back reservation for inventory %s: %v", ctx.Identity(), err)
+		}
+		return errorResponse(shared.Failure(shared.ErrorCode_Internal, "failed to save inventory")), nil
+	}
+
+	g.advance(now)
+
+	res := &shared.BuildResponse{
+		Timestamp:         timestamppb.Now(),
+		Status:            shared.Status_OK,
+		Construction:      g.scheduled(construction, now),
+		BlueprintsVersion: registry.Version(),
+	}
+	g.remember(req.GetIdempotencyKey(), request, res)
+
+	return res, nil
+}
+
+func (g *InventoryGrain) CancelBuild(req *shared.CancelBuildRequest, ctx cluster.GrainContext) (*shared.CancelBuildResponse, error) {
+	now := time.Now().UTC()
+	g.advance(now)
+
+	id := req.GetConstruction()
+	construction, ok := g.constructions[id]
+	if !ok {
+		return &shared.CancelBuildResponse{
 			Timestamp: timestamppb.Now(),
 			Status:    shared.Status_Error,
-			Context: &structpb.Struct{
-				Fields: map[string]*structpb.Value{
-					shared.KeyError: structpb.NewStringValue("requested blueprint not found"),
-				},
-			},
+			Error:     shared.Failure(shared.ErrorCode_ConstructionNotFound, "construction not found"),
 		}, nil
 	}
 
-	_, err = g.resources.Reserve(blueprint.Cost)
-	if err != nil {
-		return &shared.BuildResponse{
+	// only the recorded cost is refunded, which is what was paid even if
+	// the blueprint changed since
+	var percent int64 = 100
+	if blueprint, err := registry.Lookup(construction.Blueprint); err == nil {
+		percent = blueprint.RefundPercent
+	}
+	refund := construction.refund(percent)
+
+	if err := g.emit(EventConstructionCancelled, ConstructionCancelled{ID: id}); err != nil {
+		log.Printf("failed to record cancellation for inventory %s: %v", ctx.Identity(), err)
+		return &shared.CancelBuildResponse{
 			Timestamp: timestamppb.Now(),
 			Status:    shared.Status_Error,
-			Context: &structpb.Struct{
-				Fields: map[string]*structpb.Value{
-					shared.KeyError: structpb.NewStringValue(err.Error()),
-				},
-			},
+			Error:     shared.Failure(shared.ErrorCode_Internal, "failed to save inventory"),
 		}, nil
 	}
+	delete(g.armed, id)
 
-	// TODO handle the build time
-
-	g.buildings.Build(blueprint)
+	if err := g.emit(EventResourcesRefunded, ResourcesRefunded{Blueprint: construction.Blueprint, Amount: refund}); err != nil {
+		log.Printf("failed to refund construction %s for inventory %s: %v", id, ctx.Identity(), err)
+	}
 
-	// this should be persisted to the backend of choice immediately
+	// the cancelled construction may have held a slot
+	g.advance(now)
 
-	return &shared.BuildResponse{
-		Timestamp: timestamppb.Now(),
-		Status:    shared.Status_OK,
+	return &shared.CancelBuildResponse{
+		Timestamp:    timestamppb.Now(),
+		Status:       shared.Status_OK,
+		Construction: id,
+		Refund:       resourceAmounts(refund),
 	}, nil
 }
 
 func (g *InventoryGrain) Describe(req *shared.DescribeInventoryRequest, ctx cluster.GrainContext) (*shared.DescribeInventoryResponse, error) {
+	now := time.Now().UTC()
+	g.advance(now)
 
-	resources := make(map[string]*structpb.Value)
+	capacity := g.capacity()
 	g.resources.mx.Lock()
+	resources := make([]*shared.ResourceStock, 0, len(g.resources.store))
 	for k, v := range g.resources.store {
-		resources[k] = structpb.NewNumberValue(float64(v))
+		resources = append(resources, &shared.ResourceStock{Resource: k, Amount: v, Capacity: capacity[k]})
 	}
 	g.resources.mx.Unlock()
+	sort.Slice(resources, func(i, j int) bool { return resources[i].Resource < resources[j].Resource })
 
-	g.buildings.mx.Lock()
-	buildings := make(map[string]*structpb.Value)
-	for k, v := range g.buildings.store {
-		buildings[k] = structpb.NewNumberValue(float64(v))
+	assignments := make([]*shared.WorkerAssignment, 0, len(g.assignments))
+	for k, v := range g.assignments {
+		assignments = append(assignments, &shared.WorkerAssignment{Blueprint: k, Workers: v})
+	}
+	sort.Slice(assignments, func(i, j int) bool { return assignments[i].Blueprint < assignments[j].Blueprint })
+
+	buildings := make([]*shared.Building, 0)
+	for _, b := range g.buildings.List() {
+		buildings = append(buildings, &shared.Building{
+			ID:        b.ID,
+			Blueprint: b.Blueprint,
+			Level:     b.Level,
+			Active:    g.isActive(b),
+		})
 	}
-	defer g.buildings.mx.Unlock()
 
-	res := &shared.DescribeInventoryResponse{
-		Timestamp: timestamppb.Now(),
-		Status:    shared.Status_OK,
-		Context: &structpb.Struct{
-			Fields: map[string]*structpb.Value{
-				KeyPopulation: structpb.NewNumberValue(float64(g.population)),
-				KeyResources:  structpb.NewStructValue(&structpb.Struct{Fields: resources}),
-				KeyBuildings:  structpb.NewStructValue(&structpb.Struct{Fields: buildings}),
-			},
-		},
+	queue := make([]*shared.Construction, 0, len(g.constructions))
+	for _, c := range g.schedule(now) {
+		queue = append(queue, constructionMessage(c))
 	}
 
-	return res, nil
+	return &shared.DescribeInventoryResponse{
+		Timestamp:         timestamppb.Now(),
+		Status:            shared.Status_OK,
+		Population:        g.population,
+		Housing:           g.housing(),
+		Idle:              g.idle(),
+		Resources:         resources,
+		Production:        resourceAmounts(g.productionRates()),
+		Upkeep:            resourceAmounts(g.upkeep()),
+		Assignments:       assignments,
+		Buildings:         buildings,
+		Queue:             queue,
+		BlueprintsVersion: registry.Version(),
+		Sequence:          g.sequence,
+	}, nil
+}
+
+// resourceAmounts converts a map of resource amounts to messages ordered by
+// resource
+func resourceAmounts(amounts map[string]int64) []*shared.ResourceAmount {
+	list := make([]*shared.ResourceAmount, 0, len(amounts))
+	for k, v := range amounts {
+		list = append(list, &shared.ResourceAmount{Resource: k, Amount: v})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Resource < list[j].Resource })
+	return list
 }
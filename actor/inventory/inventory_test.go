@@ -0,0 +1,34 @@
+package inventory
+
+import (
+	"sync"
+
+	"github.com/alfreddobradi/actor-game/registry"
+)
+
+// newTestGrain returns an inventory set up like a new one, without a cluster
+// or a backend
+func newTestGrain() *InventoryGrain {
+	g := &InventoryGrain{
+		population:  100,
+		assignments: make(map[string]int64),
+		resources: &ResourceStore{
+			mx:    &sync.Mutex{},
+			store: make(map[string]int64),
+		},
+		buildings: &BuildingStore{
+			mx:    &sync.Mutex{},
+			store: make(map[string]Building),
+		},
+		constructions: make(map[string]Construction),
+		armed:         make(map[string]bool),
+		carry:         make(map[string]int64),
+		owed:          make(map[string]map[string]int64),
+		upkeepCarry:   make(map[string]int64),
+		requests:      make(map[string]completedRequest),
+	}
+	for _, resource := range registry.Resources() {
+		g.resources.store[resource.ID] = resource.Starting
+	}
+	return g
+}
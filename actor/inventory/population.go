@@ -0,0 +1,183 @@
+package inventory
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/alfreddobradi/actor-game/registry"
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
+	"github.com/asynkron/protoactor-go/cluster"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// baseHousing is the number of people a player can house without any
+	// buildings providing housing
+	baseHousing int64 = 100
+
+	// populationGrowth is the number of people moving in per hour while
+	// there is free housing
+	populationGrowth int64 = 10
+)
+
+// grow adds the people who moved in over the given number of production
+// units, never exceeding the available housing. Over the units they went
+// hungry for, people leave at the same pace instead.
+func (g *InventoryGrain) grow(units, hungry int64) {
+	perHour := int64(time.Hour / productionUnit)
+	if hungry > 0 {
+		g.hungerCarry += populationGrowth * hungry
+		left := g.hungerCarry / perHour
+		g.hungerCarry %= perHour
+		if left > g.population {
+			left = g.population
+		}
+		g.leave(g.population - left)
+		units -= hungry
+	}
+
+	housing := g.housing()
+	if g.population >= housing {
+		// housing shrinks when buildings go inactive
+		g.leave(housing)
+		g.populationCarry = 0
+		return
+	}
+
+	g.populationCarry += populationGrowth * units
+	g.population += g.populationCarry / perHour
+	g.populationCarry %= perHour
+
+	if g.population > housing {
+		g.population = housing
+	}
+}
+
+// housing returns the number of people the active buildings can house
+func (g *InventoryGrain) housing() int64 {
+	housing := baseHousing
+	for _, building := range g.buildings.List() {
+		level, err := levelOf(building)
+		if err != nil || !g.isActive(building) {
+			continue
+		}
+		housing += level.Housing
+	}
+	return housing
+}
+
+// jobs returns the number of workers the active buildings of a blueprint
+// employ
+func (g *InventoryGrain) jobs(blueprint string) int64 {
+	var jobs int64
+	for _, building := range g.buildings.List() {
+		if building.Blueprint != blueprint || !g.isActive(building) {
+			continue
+		}
+		level, err := levelOf(building)
+		if err != nil {
+			continue
+		}
+		jobs += level.Workers
+	}
+	return jobs
+}
+
+// employs reports whether buildings of the blueprint employ workers at any
+// level
+func employs(blueprint registry.Blueprint) bool {
+	if blueprint.Workers > 0 {
+		return true
+	}
+	for _, level := range blueprint.Levels {
+		if level.Workers > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// leave lowers the population to the given number of people, taking away the
+// jobs of those who left
+func (g *InventoryGrain) leave(population int64) {
+	if population >= g.population {
+		return
+	}
+	g.population = population
+	g.dismiss()
+}
+
+// dismiss scales the assignments down by the same proportion when more people
+// are assigned than live in the inventory, so no building is staffed by
+// people who left
+func (g *InventoryGrain) dismiss() {
+	var assigned int64
+	for _, workers := range g.assignments {
+		assigned += workers
+	}
+	if assigned <= g.population {
+		return
+	}
+	for blueprint, workers := range g.assignments {
+		g.assignments[blueprint] = workers * g.population / assigned
+	}
+}
+
+// idle returns the number of people not assigned to any building
+func (g *InventoryGrain) idle() int64 {
+	idle := g.population
+	for _, workers := range g.assignments {
+		idle -= workers
+	}
+	if idle < 0 {
+		idle = 0
+	}
+	return idle
+}
+
+func (g *InventoryGrain) AssignWorkers(req *shared.AssignWorkersRequest, ctx cluster.GrainContext) (*shared.AssignWorkersResponse, error) {
+	g.advance(time.Now())
+
+	blueprint, err := registry.Lookup(req.GetBlueprint())
+	if err != nil {
+		return assignmentError(shared.Failure(shared.ErrorCode_BlueprintNotFound, "requested blueprint not found")), nil
+	}
+	if !employs(blueprint) {
+		return assignmentError(shared.Failure(shared.ErrorCode_NotAnEmployer, fmt.Sprintf("%s does not employ workers", blueprint.Name))), nil
+	}
+
+	workers := req.GetWorkers()
+	if workers < 0 {
+		return assignmentError(shared.Failure(shared.ErrorCode_InvalidRequest, "number of workers cannot be negative")), nil
+	}
+
+	if jobs := g.jobs(blueprint.ID); workers > jobs {
+		return assignmentError(shared.Failure(shared.ErrorCode_NotEnoughJobs, fmt.Sprintf("%s only has room for %d workers", blueprint.Name, jobs))), nil
+	}
+
+	if available := g.idle() + g.assignments[blueprint.ID]; workers > available {
+		return assignmentError(shared.Failure(shared.ErrorCode_NotEnoughPeople, fmt.Sprintf("only %d people available", available))), nil
+	}
+
+	if err := g.emit(EventWorkersAssigned, WorkersAssigned{Blueprint: blueprint.ID, Workers: workers}); err != nil {
+		log.Printf("failed to record worker assignment for inventory %s: %v", ctx.Identity(), err)
+		return assignmentError(shared.Failure(shared.ErrorCode_Internal, "failed to save inventory")), nil
+	}
+
+	return &shared.AssignWorkersResponse{
+		Timestamp: timestamppb.Now(),
+		Status:    shared.Status_OK,
+		Blueprint: blueprint.ID,
+		Workers:   workers,
+		Idle:      g.idle(),
+	}, nil
+}
+
+func assignmentError(detail *shared.ErrorDetail) *shared.AssignWorkersResponse {
+	return &shared.AssignWorkersResponse{
+		Timestamp: timestamppb.Now(),
+		Status:    shared.Status_Error,
+		Error:     detail,
+	}
+}
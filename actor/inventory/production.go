@@ -0,0 +1,121 @@
+package inventory
+
+import (
+	"log"
+	"time"
+)
+
+// productionUnit is the granularity production is accrued at. Elapsed time is
+// counted in whole units and the remainder carried over, so accruing often
+// yields the same amount as accruing rarely as long as no building runs out
+// of upkeep in between.
+const productionUnit = time.Millisecond
+
+// baseCapacity is how much of each resource a player can store without any
+// buildings adding storage
+const baseCapacity int64 = 1000
+
+// accrue deposits everything the active buildings produced between the last
+// accrual and now, less what the population and the buildings consumed, up to
+// the storage capacity, and then lets the population grow. Which buildings
+// are active is only decided at accruals, so they produce and provide storage
+// for the whole period even if it was their upkeep that ran out. Resources are
+// only updated when the inventory is accessed or an event is applied, which
+// keeps the amounts current without a tick.
+func (g *InventoryGrain) accrue(now time.Time) {
+	if g.producedAt.IsZero() {
+		g.producedAt = now
+		return
+	}
+
+	units := int64(now.Sub(g.producedAt) / productionUnit)
+	if units <= 0 {
+		return
+	}
+	g.producedAt = g.producedAt.Add(time.Duration(units) * productionUnit)
+
+	perHour := int64(time.Hour / productionUnit)
+	capacity := g.capacity()
+	produced := make(map[string]int64)
+	for resource, rate := range g.productionRates() {
+		g.carry[resource] += rate * units
+		produced[resource] = g.carry[resource] / perHour
+		g.carry[resource] %= perHour
+	}
+
+	hungry := g.payUpkeep(units, produced)
+	g.resources.Produce(produced, capacity)
+	g.grow(units, hungry)
+}
+
+// productionRates returns the amount of each resource produced per hour by
+// all active buildings. Buildings that employ workers produce in proportion
+// to how many of their blueprint's jobs are filled.
+func (g *InventoryGrain) productionRates() map[string]int64 {
+	production := make(map[string]map[string]int64)
+	jobs := make(map[string]int64)
+	for _, building := range g.buildings.List() {
+		level, err := levelOf(building)
+		if err != nil {
+			log.Printf("inventory %s has an unknown building %s: %v", g.ctx.Identity(), building.ID, err)
+			continue
+		}
+		if !g.isActive(building) {
+			continue
+		}
+
+		if production[building.Blueprint] == nil {
+			production[building.Blueprint] = make(map[string]int64)
+		}
+		for resource, rate := range level.Production {
+			production[building.Blueprint][resource] += rate
+		}
+		jobs[building.Blueprint] += level.Workers
+	}
+
+	rates := make(map[string]int64)
+	for blueprint, amounts := range production {
+		for resource, rate := range amounts {
+			if jobs[blueprint] > 0 {
+				staffed := g.assignments[blueprint]
+				if staffed > jobs[blueprint] {
+					staffed = jobs[blueprint]
+				}
+				rate = rate * staffed / jobs[blueprint]
+			}
+			rates[resource] += rate
+		}
+	}
+	return rates
+}
+
+// capacity returns the storage capacity of every resource the inventory holds,
+// produces or has storage for. Only active buildings add storage.
+func (g *InventoryGrain) capacity() map[string]int64 {
+	capacity := make(map[string]int64)
+
+	g.resources.mx.Lock()
+	for resource := range g.resources.store {
+		capacity[resource] = baseCapacity
+	}
+	g.resources.mx.Unlock()
+
+	for _, building := range g.buildings.List() {
+		level, err := levelOf(building)
+		if err != nil || !g.isActive(building) {
+			continue
+		}
+		for resource := range level.Production {
+			if _, ok := capacity[resource]; !ok {
+				capacity[resource] = baseCapacity
+			}
+		}
+		for resource, amount := range level.Storage {
+			if _, ok := capacity[resource]; !ok {
+				capacity[resource] = baseCapacity
+			}
+			capacity[resource] += amount
+		}
+	}
+	return capacity
+}
@@ -0,0 +1,176 @@
+package inventory
+
+import (
+	"testing"
+	"time"
+)
+
+// build adds finished level 1 buildings named after their blueprints
+func build(g *InventoryGrain, blueprints ...string) {
+	for _, blueprint := range blueprints {
+		g.buildings.Build(blueprint, blueprint)
+	}
+}
+
+// accrueEvery accrues from start to end in steps of the given length
+func accrueEvery(g *InventoryGrain, start, end time.Time, step time.Duration) {
+	g.accrue(start)
+	for at := start.Add(step); at.Before(end); at = at.Add(step) {
+		g.accrue(at)
+	}
+	g.accrue(end)
+}
+
+func TestAccrueIsIndependentOfFrequency(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		wood       int64
+		buildings  []string
+		span       time.Duration
+		step       time.Duration
+		wantWood   int64
+		population int64
+	}{
+		{
+			name:       "production pays for upkeep",
+			wood:       0,
+			buildings:  []string{"house", "sawmill", "warehouse"},
+			span:       10 * time.Hour,
+			step:       time.Minute,
+			wantWood:   180,
+			population: 120,
+		},
+		{
+			name:       "steps that do not divide the span",
+			wood:       0,
+			buildings:  []string{"house", "sawmill", "warehouse"},
+			span:       10 * time.Hour,
+			step:       7*time.Second + 3*time.Millisecond,
+			wantWood:   180,
+			population: 120,
+		},
+		{
+			name:       "stock pays for upkeep",
+			wood:       100,
+			buildings:  []string{"warehouse"},
+			span:       24 * time.Hour,
+			step:       time.Minute,
+			wantWood:   52,
+			population: 100,
+		},
+	}
+
+	for _, tt := range tests {
+		once := newTestGrain()
+		often := newTestGrain()
+		for _, g := range []*InventoryGrain{once, often} {
+			g.resources.store["wood"] = tt.wood
+			g.assignments["sawmill"] = 5
+			build(g, tt.buildings...)
+		}
+
+		once.accrue(start)
+		once.accrue(start.Add(tt.span))
+		accrueEvery(often, start, start.Add(tt.span), tt.step)
+
+		for name, g := range map[string]*InventoryGrain{"once": once, "often": often} {
+			if wood := g.resources.Amount("wood"); wood != tt.wantWood {
+				t.Errorf("%s, accrued %s: want %d wood, got %d", tt.name, name, tt.wantWood, wood)
+			}
+			if g.population != tt.population {
+				t.Errorf("%s, accrued %s: want a population of %d, got %d", tt.name, name, tt.population, g.population)
+			}
+			for _, building := range g.buildings.List() {
+				if !g.isActive(building) {
+					t.Errorf("%s, accrued %s: want %s to be active", tt.name, name, building.ID)
+				}
+			}
+		}
+	}
+}
+
+func TestAccrueClampsAtCapacity(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		wood      int64
+		buildings []string
+		want      int64
+	}{
+		{"fills up to the base capacity", 990, []string{"sawmill"}, baseCapacity},
+		{"keeps what is over capacity", 1500, []string{"sawmill"}, 1500},
+		{"adds storage of active buildings", 1990, []string{"sawmill", "warehouse"}, 2000},
+		// the 20 wood of upkeep are paid out of the 200 produced
+		{"pays upkeep before clamping", 2000, []string{"sawmill", "warehouse"}, 2000},
+	}
+
+	for _, tt := range tests {
+		g := newTestGrain()
+		g.resources.store["wood"] = tt.wood
+		g.assignments["sawmill"] = 5
+		build(g, tt.buildings...)
+
+		g.accrue(start)
+		g.accrue(start.Add(10 * time.Hour))
+
+		if wood := g.resources.Amount("wood"); wood != tt.want {
+			t.Errorf("%s: want %d wood, got %d", tt.name, tt.want, wood)
+		}
+	}
+}
+
+func TestAccrueStaffsProduction(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		workers int64
+		want    int64
+	}{
+		{0, 0},
+		{1, 4},
+		{5, 20},
+		// more workers than jobs produce no more
+		{10, 20},
+	}
+
+	for _, tt := range tests {
+		g := newTestGrain()
+		g.resources.store["wood"] = 0
+		g.assignments["sawmill"] = tt.workers
+		build(g, "sawmill")
+
+		g.accrue(start)
+		g.accrue(start.Add(time.Hour))
+
+		if wood := g.resources.Amount("wood"); wood != tt.want {
+			t.Errorf("%d workers: want %d wood, got %d", tt.workers, tt.want, wood)
+		}
+	}
+}
+
+func TestAccrueGrowsPopulation(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	g := newTestGrain()
+	build(g, "house")
+
+	g.accrue(start)
+	g.accrue(start.Add(90 * time.Minute))
+	if g.population != 115 {
+		t.Errorf("want 15 people to move in, got a population of %d", g.population)
+	}
+
+	// what is left of an hour carries over to the next accrual
+	g.accrue(start.Add(2 * time.Hour))
+	if g.population != 120 {
+		t.Errorf("want the population to reach the housing of %d, got %d", g.housing(), g.population)
+	}
+
+	g.accrue(start.Add(10 * time.Hour))
+	if g.population != g.housing() {
+		t.Errorf("want the population to stay at the housing of %d, got %d", g.housing(), g.population)
+	}
+}
@@ -0,0 +1,52 @@
+package inventory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alfreddobradi/actor-game/registry"
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
+	"github.com/asynkron/protoactor-go/cluster"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// unmet describes every requirement of the blueprint the inventory does not
+// satisfy yet. Only finished buildings count towards a requirement.
+func (g *InventoryGrain) unmet(blueprint registry.Blueprint) []string {
+	reasons := make([]string, 0)
+	for _, requirement := range blueprint.Requirements {
+		name := requirement.Blueprint
+		if required, err := registry.Lookup(requirement.Blueprint); err == nil {
+			name = required.Name
+		}
+
+		if have := g.buildings.Count(requirement.Blueprint); have < requirement.Count {
+			reasons = append(reasons, fmt.Sprintf("requires %d %s, have %d", requirement.Count, name, have))
+		}
+	}
+	return reasons
+}
+
+func (g *InventoryGrain) ListBlueprints(req *shared.ListBlueprintsRequest, ctx cluster.GrainContext) (*shared.ListBlueprintsResponse, error) {
+	g.advance(time.Now())
+
+	blueprints := registry.List()
+	list := make([]*shared.BlueprintAvailability, 0, len(blueprints))
+	for _, blueprint := range blueprints {
+		reasons := g.unmet(blueprint)
+		list = append(list, &shared.BlueprintAvailability{
+			Blueprint:  blueprint.ID,
+			Name:       blueprint.Name,
+			Buildable:  len(reasons) == 0,
+			Affordable: g.resources.Check(blueprint.Cost) == nil,
+			Reasons:    reasons,
+		})
+	}
+
+	return &shared.ListBlueprintsResponse{
+		Timestamp:         timestamppb.Now(),
+		Status:            shared.Status_OK,
+		Blueprints:        list,
+		BlueprintsVersion: registry.Version(),
+	}, nil
+}
@@ -0,0 +1,119 @@
+package inventory
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/alfreddobradi/actor-game/registry"
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// levelOf returns the blueprint level a building is at. Buildings above the
+// highest level of their blueprint, as left behind by a catalog removing
+// levels, count as being at the highest level.
+func levelOf(building Building) (registry.Level, error) {
+	blueprint, err := registry.Lookup(building.Blueprint)
+	if err != nil {
+		return registry.Level{}, fmt.Errorf("%s: %w", building.Blueprint, err)
+	}
+
+	number := building.Level
+	if highest := int64(len(blueprint.Levels)) + 1; number > highest {
+		number = highest
+	}
+	level, ok := blueprint.Level(number)
+	if !ok {
+		return registry.Level{}, fmt.Errorf("%s has no level %d", building.Blueprint, building.Level)
+	}
+	return level, nil
+}
+
+// upgrading reports whether an upgrade of the building is queued or in
+// progress
+func (g *InventoryGrain) upgrading(building string) bool {
+	for _, c := range g.constructions {
+		if c.Building == building {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *InventoryGrain) UpgradeBuilding(req *shared.UpgradeBuildingRequest, ctx cluster.GrainContext) (*shared.BuildResponse, error) {
+	g.advance(time.Now())
+
+	id := req.GetBuilding()
+	request := "upgrade " + id
+	if res, ok := g.replay(req.GetIdempotencyKey(), request); ok {
+		return res, nil
+	}
+
+	building, ok := g.buildings.Get(id)
+	if !ok {
+		return errorResponse(shared.Failure(shared.ErrorCode_BuildingNotFound, "building not found")), nil
+	}
+
+	blueprint, err := registry.Lookup(building.Blueprint)
+	if err != nil {
+		return errorResponse(shared.Failure(shared.ErrorCode_BlueprintNotFound, "requested blueprint not found")), nil
+	}
+
+	level, ok := blueprint.Level(building.Level + 1)
+	if !ok {
+		return errorResponse(shared.Failure(shared.ErrorCode_MaxLevelReached, fmt.Sprintf("%s is already at its highest level", blueprint.Name))), nil
+	}
+
+	if g.upgrading(building.ID) {
+		return errorResponse(shared.Failure(shared.ErrorCode_UpgradeInProgress, "building is already being upgraded")), nil
+	}
+
+	duration, err := level.Duration()
+	if err != nil {
+		log.Printf("invalid upgrade time for level %d of blueprint %s: %v", level.Level, blueprint.ID, err)
+		return errorResponse(shared.Failure(shared.ErrorCode_Internal, "invalid blueprint upgrade time")), nil
+	}
+
+	if missing := g.resources.Missing(level.Cost); len(missing) > 0 {
+		return errorResponse(insufficient(missing)), nil
+	}
+
+	if err := g.emit(EventResourcesReserved, ResourcesReserved{Blueprint: blueprint.ID, Cost: level.Cost}); err != nil {
+		log.Printf("failed to record reservation for inventory %s: %v", ctx.Identity(), err)
+		return errorResponse(shared.Failure(shared.ErrorCode_Internal, "failed to save inventory")), nil
+	}
+
+	now := time.Now().UTC()
+	construction := Construction{
+		ID:        uuid.NewString(),
+		Blueprint: blueprint.ID,
+		Building:  building.ID,
+		Level:     level.Level,
+		Cost:      level.Cost,
+		Duration:  duration,
+		QueuedAt:  now,
+	}
+
+	if err := g.emit(EventConstructionQueued, construction); err != nil {
+		log.Printf("failed to record upgrade for inventory %s: %v", ctx.Identity(), err)
+		if err := g.emit(EventResourcesRefunded, ResourcesRefunded{Blueprint: blueprint.ID, Amount: level.Cost}); err != nil {
+			log.Printf("failed to roll back reservation for inventory %s: %v", ctx.Identity(), err)
+		}
+		return errorResponse(shared.Failure(shared.ErrorCode_Internal, "failed to save inventory")), nil
+	}
+
+	g.advance(now)
+
+	res := &shared.BuildResponse{
+		Timestamp:         timestamppb.Now(),
+		Status:            shared.Status_OK,
+		Construction:      g.scheduled(construction, now),
+		BlueprintsVersion: registry.Version(),
+	}
+	g.remember(req.GetIdempotencyKey(), request, res)
+
+	return res, nil
+}
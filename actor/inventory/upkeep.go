@@ -0,0 +1,120 @@
+package inventory
+
+import (
+	"time"
+
+	"github.com/alfreddobradi/actor-game/registry"
+)
+
+// payUpkeep charges what the population consumed and the upkeep of the active
+// buildings over the given number of production units. It is paid out of what
+// was produced over the same units first and out of the store after that, so
+// as long as there is enough it makes no difference how often it is accrued.
+// What is left of produced is what the inventory gains.
+//
+// The population eats first and what it cannot be fed is not owed. Instead
+// it returns the share of the units the population went hungry for. Buildings
+// are paid for in order of their blueprint and ID, and one that cannot be
+// paid in full keeps what it owes and is inactive until that is paid off. A
+// shortage is only noticed by the accrual that runs into it.
+func (g *InventoryGrain) payUpkeep(units int64, produced map[string]int64) (hungry int64) {
+	perHour := int64(time.Hour / productionUnit)
+
+	for _, resource := range registry.Resources() {
+		if resource.Consumption == 0 || g.population == 0 {
+			continue
+		}
+
+		consumed := g.upkeepCarry[resource.ID] + resource.Consumption*g.population*units
+		due := consumed / perHour
+		g.upkeepCarry[resource.ID] = consumed % perHour
+
+		if paid := g.pay(resource.ID, due, produced); paid < due {
+			if share := units * (due - paid) / due; share > hungry {
+				hungry = share
+			}
+		}
+	}
+
+	for _, building := range g.buildings.List() {
+		level, err := levelOf(building)
+		if err != nil {
+			continue
+		}
+
+		owed := g.owed[building.ID]
+		if owed == nil {
+			owed = make(map[string]int64)
+		}
+		// inactive buildings do not wear down but have to pay off what they
+		// owe before they are used again
+		if g.isActive(building) {
+			for resource, rate := range level.Upkeep {
+				owed[resource] += rate * units
+			}
+		}
+
+		for resource, amount := range owed {
+			paid := g.pay(resource, amount/perHour, produced)
+			owed[resource] = amount - paid*perHour
+			if owed[resource] == 0 {
+				delete(owed, resource)
+			}
+		}
+
+		if len(owed) == 0 {
+			delete(g.owed, building.ID)
+		} else {
+			g.owed[building.ID] = owed
+		}
+	}
+
+	return hungry
+}
+
+// pay takes up to amount of the resource out of produced and then out of the
+// store, returning how much it took
+func (g *InventoryGrain) pay(resource string, amount int64, produced map[string]int64) int64 {
+	taken := amount
+	if available := produced[resource]; taken > available {
+		taken = available
+	}
+	if taken < 0 {
+		taken = 0
+	}
+	produced[resource] -= taken
+	return taken + g.resources.Take(resource, amount-taken)
+}
+
+// upkeep returns the amount of each resource the population and the active
+// buildings consume per hour
+func (g *InventoryGrain) upkeep() map[string]int64 {
+	upkeep := make(map[string]int64)
+	for _, resource := range registry.Resources() {
+		if amount := resource.Consumption * g.population; amount > 0 {
+			upkeep[resource.ID] += amount
+		}
+	}
+	for _, building := range g.buildings.List() {
+		level, err := levelOf(building)
+		if err != nil || !g.isActive(building) {
+			continue
+		}
+		for resource, rate := range level.Upkeep {
+			upkeep[resource] += rate
+		}
+	}
+	return upkeep
+}
+
+// isActive reports whether the building has paid its upkeep. A building is
+// inactive while it owes at least a whole unit of any resource.
+func (g *InventoryGrain) isActive(building Building) bool {
+	perHour := int64(time.Hour / productionUnit)
+	for _, amount := range g.owed[building.ID] {
+		if amount >= perHour {
+			return false
+		}
+	}
+	return true
+}
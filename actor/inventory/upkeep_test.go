@@ -0,0 +1,131 @@
+package inventory
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alfreddobradi/actor-game/registry"
+)
+
+func TestUpkeepDebt(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	g := newTestGrain()
+	g.resources.store["wood"] = 0
+	build(g, "warehouse")
+	warehouse, _ := g.buildings.Get("warehouse")
+
+	steps := []struct {
+		name     string
+		at       time.Duration
+		deposit  int64
+		wood     int64
+		active   bool
+		capacity int64
+	}{
+		{name: "runs out", at: 2 * time.Hour, wood: 0, active: false, capacity: baseCapacity},
+		// inactive buildings do not owe more than the 4 wood of the first
+		// two hours
+		{name: "stays inactive", at: 5 * time.Hour, wood: 0, active: false, capacity: baseCapacity},
+		{name: "pays off part", at: 6 * time.Hour, deposit: 3, wood: 0, active: false, capacity: baseCapacity},
+		{name: "pays off the rest", at: 7 * time.Hour, deposit: 10, wood: 9, active: true, capacity: baseCapacity + 1000},
+		{name: "pays as it goes", at: 9 * time.Hour, wood: 5, active: true, capacity: baseCapacity + 1000},
+	}
+
+	g.accrue(start)
+	for _, step := range steps {
+		g.resources.Deposit(map[string]int64{"wood": step.deposit})
+		g.accrue(start.Add(step.at))
+
+		if wood := g.resources.Amount("wood"); wood != step.wood {
+			t.Errorf("%s: want %d wood, got %d", step.name, step.wood, wood)
+		}
+		if active := g.isActive(warehouse); active != step.active {
+			t.Errorf("%s: want active %t, got %t", step.name, step.active, active)
+		}
+		if capacity := g.capacity()["wood"]; capacity != step.capacity {
+			t.Errorf("%s: want a capacity of %d, got %d", step.name, step.capacity, capacity)
+		}
+	}
+}
+
+func TestInactiveBuildingsProduceNothing(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	g := newTestGrain()
+	g.resources.store["wood"] = 0
+	g.assignments["sawmill"] = 5
+	build(g, "sawmill")
+	g.owed["sawmill"] = map[string]int64{"wood": int64(time.Hour / productionUnit)}
+
+	g.accrue(start)
+	g.accrue(start.Add(time.Hour))
+
+	if wood := g.resources.Amount("wood"); wood != 0 {
+		t.Errorf("want no wood from a building in debt, got %d", wood)
+	}
+	if jobs := g.jobs("sawmill"); jobs != 0 {
+		t.Errorf("want no jobs in a building in debt, got %d", jobs)
+	}
+}
+
+// loadCatalog replaces the catalog with the default one changed by replace
+// for the duration of the test
+func loadCatalog(t *testing.T, replace ...string) {
+	t.Helper()
+
+	source, err := os.ReadFile("../../registry/blueprints.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "blueprints.json")
+	if err := os.WriteFile(path, []byte(strings.NewReplacer(replace...).Replace(string(source))), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.LoadFile(path); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := registry.LoadFile("../../registry/blueprints.json"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestHungryPeopleLeave(t *testing.T) {
+	loadCatalog(t, `"starting_amount": 50`, `"starting_amount": 50, "consumption": 1`)
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		food       int64
+		population int64
+		wantFood   int64
+	}{
+		// 100 people eat 100 food an hour
+		{"fed", 100, 100, 0},
+		{"fed with some left", 150, 100, 50},
+		// 5 people leave over the hungry half of the hour and 5 move into
+		// the housing they left over the other half
+		{"hungry half the time", 50, 100, 0},
+		{"hungry all the time", 0, 90, 0},
+	}
+
+	for _, tt := range tests {
+		g := newTestGrain()
+		g.resources.store["food"] = tt.food
+
+		g.accrue(start)
+		g.accrue(start.Add(time.Hour))
+
+		if food := g.resources.Amount("food"); food != tt.wantFood {
+			t.Errorf("%s: want %d food, got %d", tt.name, tt.wantFood, food)
+		}
+		if g.population != tt.population {
+			t.Errorf("%s: want a population of %d, got %d", tt.name, tt.population, g.population)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package player
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/alfreddobradi/actor-game/persistence"
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
+	"github.com/asynkron/protoactor-go/cluster"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	Kind string = "Player"
+
+	persistenceKind string = "player"
+
+	minNameLength     int = 3
+	maxNameLength     int = 32
+	minPasswordLength int = 8
+	// bcrypt ignores everything after the first 72 bytes
+	maxPasswordLength int = 72
+)
+
+// dummyHash is compared against when logging in to a player that does not
+// exist so the response time does not reveal which names are taken
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("not a password"), bcrypt.DefaultCost)
+
+// Profile is the persisted account of a player
+type Profile struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	CreatedAt    time.Time `json:"created_at"`
+	PasswordHash []byte    `json:"password_hash"`
+}
+
+// PlayerGrain holds the account of the player whose ID is its identity. IDs
+// are derived from the player name by shared.GeneratePlayerID.
+type PlayerGrain struct {
+	ctx     cluster.GrainContext
+	backend persistence.Backend
+
+	profile *Profile
+}
+
+func New(backend persistence.Backend) *PlayerGrain {
+	return &PlayerGrain{backend: backend}
+}
+
+func (g *PlayerGrain) Init(ctx cluster.GrainContext) {
+	g.ctx = ctx
+
+	if err := g.load(); err != nil {
+		log.Printf("failed to load player %s: %v", ctx.Identity(), err)
+	}
+}
+
+func (g *PlayerGrain) Terminate(ctx cluster.GrainContext) {}
+
+func (g *PlayerGrain) ReceiveDefault(ctx cluster.GrainContext) {}
+
+func (g *PlayerGrain) Register(req *shared.RegisterPlayerRequest, ctx cluster.GrainContext) (*shared.PlayerResponse, error) {
+	if g.profile != nil {
+		return errorResponse(shared.Failure(shared.ErrorCode_PlayerExists, "name is already taken")), nil
+	}
+
+	name := strings.TrimSpace(req.GetName())
+	if n := utf8.RuneCountInString(name); n < minNameLength || n > maxNameLength {
+		return errorResponse(shared.Failure(shared.ErrorCode_InvalidRequest, fmt.Sprintf("name must be %d to %d characters long", minNameLength, maxNameLength))), nil
+	}
+	if shared.GeneratePlayerID(name).String() != ctx.Identity() {
+		return errorResponse(shared.Failure(shared.ErrorCode_InvalidRequest, "name does not belong to this player")), nil
+	}
+
+	if n := len(req.GetPassword()); n < minPasswordLength || n > maxPasswordLength {
+		return errorResponse(shared.Failure(shared.ErrorCode_InvalidRequest, fmt.Sprintf("password must be %d to %d bytes long", minPasswordLength, maxPasswordLength))), nil
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.GetPassword()), bcrypt.DefaultCost)
+	if err != nil {
+		log.Printf("failed to hash password of player %s: %v", ctx.Identity(), err)
+		return errorResponse(shared.Failure(shared.ErrorCode_Internal, "failed to register player")), nil
+	}
+
+	g.profile = &Profile{
+		ID:           ctx.Identity(),
+		Name:         name,
+		CreatedAt:    time.Now().UTC(),
+		PasswordHash: hash,
+	}
+	if err := g.persist(); err != nil {
+		g.profile = nil
+		log.Printf("failed to persist player %s: %v", ctx.Identity(), err)
+		return errorResponse(shared.Failure(shared.ErrorCode_Internal, "failed to save player")), nil
+	}
+
+	return g.response(), nil
+}
+
+func (g *PlayerGrain) Login(req *shared.LoginRequest, ctx cluster.GrainContext) (*shared.PlayerResponse, error) {
+	hash := dummyHash
+	if g.profile != nil {
+		hash = g.profile.PasswordHash
+	}
+
+	if err := bcrypt.CompareHashAndPassword(hash, []byte(req.GetPassword())); err != nil || g.profile == nil {
+		return errorResponse(shared.Failure(shared.ErrorCode_InvalidCredentials, "invalid name or password")), nil
+	}
+
+	return g.response(), nil
+}
+
+func (g *PlayerGrain) response() *shared.PlayerResponse {
+	return &shared.PlayerResponse{
+		Timestamp: timestamppb.Now(),
+		Status:    shared.Status_OK,
+		Player: &shared.PlayerProfile{
+			ID:        g.profile.ID,
+			Name:      g.profile.Name,
+			CreatedAt: timestamppb.New(g.profile.CreatedAt),
+		},
+	}
+}
+
+func errorResponse(detail *shared.ErrorDetail) *shared.PlayerResponse {
+	return &shared.PlayerResponse{
+		Timestamp: timestamppb.Now(),
+		Status:    shared.Status_Error,
+		Error:     detail,
+	}
+}
+
+func (g *PlayerGrain) load() error {
+	if g.backend == nil {
+		return nil
+	}
+
+	data, err := g.backend.Load(persistenceKind, g.ctx.Identity())
+	if errors.Is(err, persistence.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	profile := &Profile{}
+	if err := json.Unmarshal(data, profile); err != nil {
+		return err
+	}
+	g.profile = profile
+
+	return nil
+}
+
+func (g *PlayerGrain) persist() error {
+	if g.backend == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(g.profile)
+	if err != nil {
+		return err
+	}
+
+	return g.backend.Save(persistenceKind, g.ctx.Identity(), data)
+}
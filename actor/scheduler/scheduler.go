@@ -1,26 +1,256 @@
 package scheduler
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
 	"log"
+	"time"
 
+	"github.com/alfreddobradi/actor-game/persistence"
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
 	"github.com/asynkron/protoactor-go/cluster"
+	protoscheduler "github.com/asynkron/protoactor-go/scheduler"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	// Shards is the number of scheduler grains jobs are spread across
+	Shards uint32 = 16
+
+	Kind string = "Scheduler"
+
+	persistenceKind string        = "scheduler"
+	retryDelay      time.Duration = 10 * time.Second
+)
+
+// Job is a grain method invocation to be performed at a given time. Method is
+// resolved when the job fires so saved jobs keep calling the same method when
+// rpcs are added to the service. Payload is the marshalled request message of
+// the method.
+type Job struct {
+	ID       string    `json:"id"`
+	Kind     string    `json:"kind"`
+	Identity string    `json:"identity"`
+	Method   string    `json:"method"`
+	Payload  []byte    `json:"payload"`
+	At       time.Time `json:"at"`
+}
+
+// due is sent by the scheduler to itself when a job should fire
+type due struct {
+	id string
+}
+
+// Identity returns the scheduler shard responsible for jobs targeting the
+// given grain identity
+func Identity(target string) string {
+	h := fnv.New32a()
+	h.Write([]byte(target)) // nolint
+	return fmt.Sprintf("scheduler-%d", h.Sum32()%Shards)
+}
+
+// Activate makes sure every scheduler shard is running on some member so jobs
+// persisted before a restart fire without waiting for a new Schedule call.
+func Activate(c *cluster.Cluster) {
+	for i := uint32(0); i < Shards; i++ {
+		if pid := c.Get(fmt.Sprintf("scheduler-%d", i), Kind); pid == nil {
+			log.Printf("failed to activate scheduler shard %d", i)
+		}
+	}
+}
+
 type SchedulerGrain struct {
-	ctx cluster.GrainContext
+	ctx     cluster.GrainContext
+	backend persistence.Backend
+	timers  *protoscheduler.TimerScheduler
+
+	jobs    map[string]Job
+	cancels map[string]protoscheduler.CancelFunc
+}
+
+func New(backend persistence.Backend) *SchedulerGrain {
+	return &SchedulerGrain{backend: backend}
 }
 
 func (g *SchedulerGrain) Init(ctx cluster.GrainContext) {
 	g.ctx = ctx
+	g.timers = protoscheduler.NewTimerScheduler(ctx.ActorSystem().Root)
+	g.jobs = make(map[string]Job)
+	g.cancels = make(map[string]protoscheduler.CancelFunc)
+
+	if err := g.load(); err != nil {
+		log.Printf("failed to load scheduler %s: %v", ctx.Identity(), err)
+	}
+
+	for _, job := range g.jobs {
+		g.arm(job.ID, time.Until(job.At))
+	}
+}
+
+func (g *SchedulerGrain) Terminate(ctx cluster.GrainContext) {
+	for _, cancel := range g.cancels {
+		cancel()
+	}
+
+	if err := g.persist(); err != nil {
+		log.Printf("failed to persist scheduler %s: %v", ctx.Identity(), err)
+	}
 }
 
-func (g SchedulerGrain) Terminate(ctx cluster.GrainContext) {
-	g.persist() // nolint
+func (g *SchedulerGrain) ReceiveDefault(ctx cluster.GrainContext) {
+	switch msg := ctx.Message().(type) {
+	case *due:
+		g.fire(msg.id)
+	case *cluster.GrainErrorResponse:
+		log.Printf("scheduled job failed: %s", msg.Err)
+	}
 }
 
-func (g SchedulerGrain) ReceiveDefault(ctx cluster.GrainContext) {}
+func (g *SchedulerGrain) Schedule(req *shared.ScheduleRequest, ctx cluster.GrainContext) (*shared.ScheduleResponse, error) {
+	job, err := parseJob(req)
+	if err != nil {
+		return &shared.ScheduleResponse{
+			Timestamp: timestamppb.Now(),
+			Status:    shared.Status_Error,
+			Error:     shared.Failure(shared.ErrorCode_InvalidRequest, err.Error()),
+		}, nil
+	}
+
+	if _, ok := ctx.Cluster().TryGetClusterKind(job.Kind); !ok {
+		return &shared.ScheduleResponse{
+			Timestamp: timestamppb.Now(),
+			Status:    shared.Status_Error,
+			Error:     shared.Failure(shared.ErrorCode_InvalidRequest, fmt.Sprintf("unknown grain kind %s", job.Kind)),
+		}, nil
+	}
+
+	g.jobs[job.ID] = job
+	if err := g.persist(); err != nil {
+		delete(g.jobs, job.ID)
+		log.Printf("failed to persist scheduler %s: %v", ctx.Identity(), err)
+		return &shared.ScheduleResponse{
+			Timestamp: timestamppb.Now(),
+			Status:    shared.Status_Error,
+			Error:     shared.Failure(shared.ErrorCode_Internal, "failed to save job"),
+		}, nil
+	}
+
+	g.arm(job.ID, time.Until(job.At))
+
+	return &shared.ScheduleResponse{
+		Timestamp: timestamppb.Now(),
+		Status:    shared.Status_OK,
+		Job:       job.ID,
+	}, nil
+}
+
+func parseJob(req *shared.ScheduleRequest) (Job, error) {
+	job := Job{
+		ID:       uuid.NewString(),
+		Kind:     req.GetKind(),
+		Identity: req.GetIdentity(),
+		Method:   req.GetMethod(),
+		Payload:  req.GetPayload(),
+	}
+	if job.Kind == "" || job.Identity == "" {
+		return Job{}, fmt.Errorf("job target cannot be empty")
+	}
+	if _, err := shared.MethodIndex(job.Kind, job.Method); err != nil {
+		return Job{}, err
+	}
+
+	if err := req.GetAt().CheckValid(); err != nil {
+		return Job{}, fmt.Errorf("invalid job time: %w", err)
+	}
+	job.At = req.GetAt().AsTime()
+
+	return job, nil
+}
+
+func (g *SchedulerGrain) arm(id string, delay time.Duration) {
+	if delay < 0 {
+		delay = 0
+	}
+	g.cancels[id] = g.timers.SendOnce(delay, g.ctx.Self(), &due{id: id})
+}
+
+// fire invokes the target of a job and forgets about it. The job is only
+// removed after it was sent, so a crash in between fires it again on the next
+// activation: targets have to tolerate duplicate invocations.
+func (g *SchedulerGrain) fire(id string) {
+	job, ok := g.jobs[id]
+	if !ok {
+		return
+	}
+	delete(g.cancels, id)
+
+	method, err := shared.MethodIndex(job.Kind, job.Method)
+	if err != nil {
+		log.Printf("dropping job %s (%s/%s): %v", id, job.Kind, job.Identity, err)
+		g.forget(id)
+		return
+	}
+
+	pid := g.ctx.Cluster().Get(job.Identity, job.Kind)
+	if pid == nil {
+		log.Printf("target of job %s (%s/%s) not available, retrying in %s", id, job.Kind, job.Identity, retryDelay)
+		g.arm(id, retryDelay)
+		return
+	}
+
+	g.ctx.Request(pid, &cluster.GrainRequest{MethodIndex: method, MessageData: job.Payload})
+	g.forget(id)
+}
+
+// forget removes a job that fired or can no longer fire
+func (g *SchedulerGrain) forget(id string) {
+	delete(g.jobs, id)
+	if err := g.persist(); err != nil {
+		log.Printf("failed to persist scheduler %s: %v", g.ctx.Identity(), err)
+	}
+}
+
+func (g *SchedulerGrain) load() error {
+	if g.backend == nil {
+		return nil
+	}
+
+	data, err := g.backend.Load(persistenceKind, g.ctx.Identity())
+	if errors.Is(err, persistence.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	jobs := make([]Job, 0)
+	if err := json.Unmarshal(data, &jobs); err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		g.jobs[job.ID] = job
+	}
 
-func (g *SchedulerGrain) persist() error {
-	log.Println("dummy persist scheduler")
 	return nil
 }
+
+func (g *SchedulerGrain) persist() error {
+	if g.backend == nil {
+		return nil
+	}
+
+	jobs := make([]Job, 0, len(g.jobs))
+	for _, job := range g.jobs {
+		jobs = append(jobs, job)
+	}
+
+	data, err := json.Marshal(jobs)
+	if err != nil {
+		return err
+	}
+
+	return g.backend.Save(persistenceKind, g.ctx.Identity(), data)
+}
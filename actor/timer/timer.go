@@ -0,0 +1,74 @@
+package timer
+
+import (
+	"fmt"
+	"log"
+
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/asynkron/protoactor-go/scheduler"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// expired is sent by the timer to itself once the delay has elapsed so the
+// target is resolved at delivery time instead of at scheduling time.
+type expired struct {
+	kind     string
+	identity string
+	payload  []byte
+}
+
+// TimerGrain delivers a shared.TimerFired message to a target grain after a
+// delay. Timers only live in memory, targets are expected to cope with a
+// missed delivery after a restart.
+type TimerGrain struct {
+	ctx       cluster.GrainContext
+	scheduler *scheduler.TimerScheduler
+}
+
+func (t *TimerGrain) Init(ctx cluster.GrainContext) {
+	t.ctx = ctx
+	t.scheduler = scheduler.NewTimerScheduler(ctx.ActorSystem().Root)
+}
+
+func (t *TimerGrain) Terminate(ctx cluster.GrainContext) {}
+
+func (t *TimerGrain) ReceiveDefault(ctx cluster.GrainContext) {
+	msg, ok := ctx.Message().(*expired)
+	if !ok {
+		return
+	}
+
+	pid := ctx.Cluster().Get(msg.identity, msg.kind)
+	if pid == nil {
+		log.Printf("timer target %s/%s not available", msg.kind, msg.identity)
+		return
+	}
+
+	ctx.Send(pid, &shared.TimerFired{
+		Timestamp: timestamppb.Now(),
+		Payload:   msg.payload,
+	})
+}
+
+func (t *TimerGrain) Start(req *shared.StartTimerRequest, ctx cluster.GrainContext) (*shared.Noop, error) {
+	if req.GetKind() == "" || req.GetIdentity() == "" {
+		return nil, fmt.Errorf("timer target cannot be empty")
+	}
+
+	if err := req.GetDelay().CheckValid(); err != nil {
+		return nil, fmt.Errorf("invalid timer delay: %w", err)
+	}
+	delay := req.GetDelay().AsDuration()
+	if delay < 0 {
+		delay = 0
+	}
+
+	t.scheduler.SendOnce(delay, ctx.Self(), &expired{
+		kind:     req.GetKind(),
+		identity: req.GetIdentity(),
+		payload:  req.GetPayload(),
+	})
+
+	return &shared.Noop{}, nil
+}
@@ -1,10 +1,47 @@
 package api
 
+import "github.com/alfreddobradi/actor-game/registry"
+
 type BuildRequest struct {
 	Blueprint string `json:"blueprint"`
 }
 
 type BuildResponse struct {
-	Status string `json:"status"`
-	Error  string `json:"error,omitempty"`
+	Status            string        `json:"status"`
+	Error             *Error        `json:"error,omitempty"`
+	Construction      *Construction `json:"construction,omitempty"`
+	BlueprintsVersion int64         `json:"blueprints_version,omitempty"`
+}
+
+type AssignWorkersRequest struct {
+	Blueprint string `json:"blueprint"`
+	Workers   int64  `json:"workers"`
+}
+
+type AssignWorkersResponse struct {
+	Status    string `json:"status"`
+	Error     *Error `json:"error,omitempty"`
+	Blueprint string `json:"blueprint,omitempty"`
+	Workers   int64  `json:"workers"`
+	Idle      int64  `json:"idle"`
+}
+
+type CancelBuildResponse struct {
+	Status       string           `json:"status"`
+	Error        *Error           `json:"error,omitempty"`
+	Construction string           `json:"construction,omitempty"`
+	Refund       map[string]int64 `json:"refund,omitempty"`
+}
+
+type DemolishResponse struct {
+	Status   string           `json:"status"`
+	Error    *Error           `json:"error,omitempty"`
+	Building string           `json:"building,omitempty"`
+	Salvage  map[string]int64 `json:"salvage,omitempty"`
+}
+
+type BlueprintsResponse struct {
+	Version    int64                `json:"version"`
+	Resources  []registry.Resource  `json:"resources"`
+	Blueprints []registry.Blueprint `json:"blueprints"`
 }
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"net/http"
+
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
+)
+
+// Error is the JSON representation of a refused request
+type Error struct {
+	Code    string           `json:"code"`
+	Message string           `json:"message"`
+	Missing map[string]int64 `json:"missing,omitempty"`
+	Reasons []string         `json:"reasons,omitempty"`
+}
+
+type errorCode struct {
+	name   string
+	status int
+}
+
+var errorCodes = map[shared.ErrorCode]errorCode{
+	shared.ErrorCode_Internal:              {"internal", http.StatusInternalServerError},
+	shared.ErrorCode_InvalidRequest:        {"invalid_request", http.StatusBadRequest},
+	shared.ErrorCode_Unauthorized:          {"unauthorized", http.StatusUnauthorized},
+	shared.ErrorCode_BlueprintNotFound:     {"blueprint_not_found", http.StatusNotFound},
+	shared.ErrorCode_ConstructionNotFound:  {"construction_not_found", http.StatusNotFound},
+	shared.ErrorCode_BuildingNotFound:      {"building_not_found", http.StatusNotFound},
+	shared.ErrorCode_InsufficientResources: {"insufficient_resources", http.StatusConflict},
+	shared.ErrorCode_RequirementUnmet:      {"requirement_unmet", http.StatusConflict},
+	shared.ErrorCode_MaxLevelReached:       {"max_level_reached", http.StatusConflict},
+	shared.ErrorCode_UpgradeInProgress:     {"upgrade_in_progress", http.StatusConflict},
+	shared.ErrorCode_BuildingRequired:      {"building_required", http.StatusConflict},
+	shared.ErrorCode_NotAnEmployer:         {"not_an_employer", http.StatusBadRequest},
+	shared.ErrorCode_NotEnoughJobs:         {"not_enough_jobs", http.StatusConflict},
+	shared.ErrorCode_NotEnoughPeople:       {"not_enough_people", http.StatusConflict},
+	shared.ErrorCode_PlayerExists:          {"player_exists", http.StatusConflict},
+	shared.ErrorCode_InvalidCredentials:    {"invalid_credentials", http.StatusUnauthorized},
+	shared.ErrorCode_IdempotencyKeyReused:  {"idempotency_key_reused", http.StatusUnprocessableEntity},
+	shared.ErrorCode_RateLimited:           {"rate_limited", http.StatusTooManyRequests},
+}
+
+// NewError converts the error detail of a grain response. Unknown codes are
+// reported as internal errors.
+func NewError(detail *shared.ErrorDetail) *Error {
+	if detail == nil {
+		return nil
+	}
+
+	code, ok := errorCodes[detail.GetCode()]
+	if !ok {
+		code = errorCodes[shared.ErrorCode_Internal]
+	}
+
+	err := &Error{
+		Code:    code.name,
+		Message: detail.GetMessage(),
+		Reasons: detail.GetReasons(),
+	}
+	if missing := detail.GetMissing(); len(missing) > 0 {
+		err.Missing = amounts(missing)
+	}
+	return err
+}
+
+// HTTPStatus returns the status code a response carrying the error detail is
+// sent with
+func HTTPStatus(detail *shared.ErrorDetail) int {
+	if detail == nil {
+		return http.StatusOK
+	}
+	if code, ok := errorCodes[detail.GetCode()]; ok {
+		return code.status
+	}
+	return http.StatusInternalServerError
+}
+
+// ErrorResponse returns the body of a request refused before it reached a
+// grain
+func ErrorResponse(code shared.ErrorCode, message string) BuildResponse {
+	return BuildResponse{
+		Status: shared.Status_Error.String(),
+		Error:  NewError(shared.Failure(code, message)),
+	}
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"time"
+
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type InventoryResponse struct {
+	Status            string           `json:"status"`
+	Error             *Error           `json:"error,omitempty"`
+	Population        int64            `json:"population"`
+	Housing           int64            `json:"housing"`
+	Idle              int64            `json:"idle"`
+	Assignments       map[string]int64 `json:"assignments"`
+	Resources         map[string]int64 `json:"resources"`
+	Capacity          map[string]int64 `json:"capacity"`
+	Production        map[string]int64 `json:"production"`
+	Upkeep            map[string]int64 `json:"upkeep"`
+	Buildings         []Building       `json:"buildings"`
+	Queue             []Construction   `json:"queue"`
+	BlueprintsVersion int64            `json:"blueprints_version"`
+	// Sequence is the last event reflected in the response. Streamed
+	// changes up to it are already included.
+	Sequence uint64 `json:"sequence"`
+}
+
+type Building struct {
+	ID        string `json:"id"`
+	Blueprint string `json:"blueprint"`
+	Level     int64  `json:"level"`
+	Active    bool   `json:"active"`
+}
+
+type Construction struct {
+	ID          string     `json:"id"`
+	Blueprint   string     `json:"blueprint"`
+	Status      string     `json:"status"`
+	QueuedAt    time.Time  `json:"queued_at"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletesAt *time.Time `json:"completes_at,omitempty"`
+	Building    string     `json:"building,omitempty"`
+	Level       int64      `json:"level,omitempty"`
+}
+
+type InventoryBlueprintsResponse struct {
+	Status            string                  `json:"status"`
+	Error             *Error                  `json:"error,omitempty"`
+	Blueprints        []BlueprintAvailability `json:"blueprints"`
+	BlueprintsVersion int64                   `json:"blueprints_version"`
+}
+
+type BlueprintAvailability struct {
+	Blueprint  string   `json:"blueprint"`
+	Name       string   `json:"name"`
+	Buildable  bool     `json:"buildable"`
+	Affordable bool     `json:"affordable"`
+	Reasons    []string `json:"reasons"`
+}
+
+// NewInventoryResponse converts the inventory description returned by the
+// inventory grain
+func NewInventoryResponse(res *shared.DescribeInventoryResponse) InventoryResponse {
+	response := InventoryResponse{
+		Status:            res.GetStatus().String(),
+		Error:             NewError(res.GetError()),
+		Population:        res.GetPopulation(),
+		Housing:           res.GetHousing(),
+		Idle:              res.GetIdle(),
+		Assignments:       make(map[string]int64),
+		Resources:         make(map[string]int64),
+		Capacity:          make(map[string]int64),
+		Production:        amounts(res.GetProduction()),
+		Upkeep:            amounts(res.GetUpkeep()),
+		Buildings:         make([]Building, 0, len(res.GetBuildings())),
+		Queue:             make([]Construction, 0, len(res.GetQueue())),
+		BlueprintsVersion: res.GetBlueprintsVersion(),
+		Sequence:          res.GetSequence(),
+	}
+
+	for _, a := range res.GetAssignments() {
+		response.Assignments[a.GetBlueprint()] = a.GetWorkers()
+	}
+	for _, r := range res.GetResources() {
+		response.Resources[r.GetResource()] = r.GetAmount()
+		response.Capacity[r.GetResource()] = r.GetCapacity()
+	}
+	for _, b := range res.GetBuildings() {
+		response.Buildings = append(response.Buildings, Building{
+			ID:        b.GetID(),
+			Blueprint: b.GetBlueprint(),
+			Level:     b.GetLevel(),
+			Active:    b.GetActive(),
+		})
+	}
+	for _, c := range res.GetQueue() {
+		response.Queue = append(response.Queue, *NewConstruction(c))
+	}
+
+	return response
+}
+
+// NewInventoryBlueprintsResponse converts the blueprint availability returned
+// by the inventory grain
+func NewInventoryBlueprintsResponse(res *shared.ListBlueprintsResponse) InventoryBlueprintsResponse {
+	response := InventoryBlueprintsResponse{
+		Status:            res.GetStatus().String(),
+		Error:             NewError(res.GetError()),
+		Blueprints:        make([]BlueprintAvailability, 0, len(res.GetBlueprints())),
+		BlueprintsVersion: res.GetBlueprintsVersion(),
+	}
+	for _, b := range res.GetBlueprints() {
+		reasons := b.GetReasons()
+		if reasons == nil {
+			reasons = make([]string, 0)
+		}
+		response.Blueprints = append(response.Blueprints, BlueprintAvailability{
+			Blueprint:  b.GetBlueprint(),
+			Name:       b.GetName(),
+			Buildable:  b.GetBuildable(),
+			Affordable: b.GetAffordable(),
+			Reasons:    reasons,
+		})
+	}
+	return response
+}
+
+// NewBuildResponse converts the result of starting a build or an upgrade
+func NewBuildResponse(res *shared.BuildResponse) BuildResponse {
+	return BuildResponse{
+		Status:            res.GetStatus().String(),
+		Error:             NewError(res.GetError()),
+		Construction:      NewConstruction(res.GetConstruction()),
+		BlueprintsVersion: res.GetBlueprintsVersion(),
+	}
+}
+
+// NewCancelBuildResponse converts the result of cancelling a construction
+func NewCancelBuildResponse(res *shared.CancelBuildResponse) CancelBuildResponse {
+	return CancelBuildResponse{
+		Status:       res.GetStatus().String(),
+		Error:        NewError(res.GetError()),
+		Construction: res.GetConstruction(),
+		Refund:       amounts(res.GetRefund()),
+	}
+}
+
+// NewDemolishResponse converts the result of demolishing a building
+func NewDemolishResponse(res *shared.DemolishResponse) DemolishResponse {
+	return DemolishResponse{
+		Status:   res.GetStatus().String(),
+		Error:    NewError(res.GetError()),
+		Building: res.GetBuilding(),
+		Salvage:  amounts(res.GetSalvage()),
+	}
+}
+
+// NewAssignWorkersResponse converts the result of assigning workers
+func NewAssignWorkersResponse(res *shared.AssignWorkersResponse) AssignWorkersResponse {
+	return AssignWorkersResponse{
+		Status:    res.GetStatus().String(),
+		Error:     NewError(res.GetError()),
+		Blueprint: res.GetBlueprint(),
+		Workers:   res.GetWorkers(),
+		Idle:      res.GetIdle(),
+	}
+}
+
+// NewConstruction converts a construction message, returning nil for a
+// missing one
+func NewConstruction(c *shared.Construction) *Construction {
+	if c == nil {
+		return nil
+	}
+
+	return &Construction{
+		ID:          c.GetID(),
+		Blueprint:   c.GetBlueprint(),
+		Status:      c.GetStatus(),
+		QueuedAt:    timeOf(c.GetQueuedAt()),
+		StartedAt:   optionalTime(c.GetStartedAt()),
+		CompletesAt: optionalTime(c.GetCompletesAt()),
+		Building:    c.GetBuilding(),
+		Level:       c.GetLevel(),
+	}
+}
+
+func amounts(list []*shared.ResourceAmount) map[string]int64 {
+	amounts := make(map[string]int64, len(list))
+	for _, a := range list {
+		amounts[a.GetResource()] += a.GetAmount()
+	}
+	return amounts
+}
+
+func timeOf(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
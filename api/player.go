@@ -0,0 +1,43 @@
+package api
+
+import (
+	"time"
+
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
+)
+
+type PlayerRequest struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+type Player struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SessionResponse struct {
+	Status    string     `json:"status"`
+	Error     *Error     `json:"error,omitempty"`
+	Player    *Player    `json:"player,omitempty"`
+	Token     string     `json:"token,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// NewSessionResponse converts the result of registering or logging in to a
+// player. The token is added by the caller.
+func NewSessionResponse(res *shared.PlayerResponse) SessionResponse {
+	response := SessionResponse{
+		Status: res.GetStatus().String(),
+		Error:  NewError(res.GetError()),
+	}
+	if p := res.GetPlayer(); p != nil {
+		response.Player = &Player{
+			ID:        p.GetID(),
+			Name:      p.GetName(),
+			CreatedAt: timeOf(p.GetCreatedAt()),
+		}
+	}
+	return response
+}
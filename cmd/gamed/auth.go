@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/alfreddobradi/actor-game/api"
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+type contextKey string
+
+const playerKey contextKey = "player"
+
+var errNoExpiry = errors.New("token has no expiry")
+
+// authenticator verifies HMAC signed bearer tokens. The subject of a token is
+// the ID of the player it was issued to.
+type authenticator struct {
+	key    []byte
+	ttl    time.Duration
+	parser *jwt.Parser
+}
+
+func newAuthenticator(key []byte, ttl time.Duration) *authenticator {
+	return &authenticator{
+		key:    key,
+		ttl:    ttl,
+		parser: jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()})),
+	}
+}
+
+// issue signs a token for the player that expires after the configured ttl
+func (a *authenticator) issue(player uuid.UUID) (string, time.Time, error) {
+	now := time.Now()
+	expires := now.Add(a.ttl)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   player.String(),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expires),
+	})
+
+	signed, err := token.SignedString(a.key)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expires, nil
+}
+
+// middleware rejects requests without a valid token and makes the player ID
+// available to the handlers through playerID
+func (a *authenticator) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		token := strings.TrimPrefix(header, "Bearer ")
+		if header == "" || token == header {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, shared.ErrorCode_Unauthorized, "missing bearer token")
+			return
+		}
+
+		player, err := a.verify(token)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			writeError(w, shared.ErrorCode_Unauthorized, "invalid bearer token")
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), playerKey, player)))
+	})
+}
+
+// verify checks the signature and expiry of the token and returns the player
+// it was issued to
+func (a *authenticator) verify(token string) (uuid.UUID, error) {
+	claims := &jwt.RegisteredClaims{}
+	if _, err := a.parser.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return a.key, nil
+	}); err != nil {
+		return uuid.Nil, err
+	}
+
+	// tokens without an expiry would be valid forever
+	if claims.ExpiresAt == nil {
+		return uuid.Nil, errNoExpiry
+	}
+
+	return uuid.Parse(claims.Subject)
+}
+
+// writeSession responds to a registration or login with a token for the
+// player
+func writeSession(w http.ResponseWriter, a *authenticator, res *shared.PlayerResponse) {
+	response := api.NewSessionResponse(res)
+	if response.Error != nil {
+		writeJSON(w, api.HTTPStatus(res.GetError()), response)
+		return
+	}
+
+	player, err := uuid.Parse(res.GetPlayer().GetID())
+	if err != nil {
+		log.Printf("invalid player id %q: %v", res.GetPlayer().GetID(), err)
+		writeError(w, shared.ErrorCode_Internal, http.StatusText(http.StatusInternalServerError))
+		return
+	}
+
+	token, expires, err := a.issue(player)
+	if err != nil {
+		log.Printf("failed to issue token for player %s: %v", player, err)
+		writeError(w, shared.ErrorCode_Internal, http.StatusText(http.StatusInternalServerError))
+		return
+	}
+	response.Token = token
+	response.ExpiresAt = &expires
+
+	writeJSON(w, http.StatusCreated, response)
+}
+
+// playerID returns the player authenticated by the middleware
+func playerID(r *http.Request) uuid.UUID {
+	player, _ := r.Context().Value(playerKey).(uuid.UUID)
+	return player
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+var testKey = []byte("test-key")
+
+// sign returns a token with the claims signed by method
+func sign(t *testing.T, method jwt.SigningMethod, key interface{}, claims jwt.Claims) string {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestVerify(t *testing.T) {
+	a := newAuthenticator(testKey, time.Hour)
+	player := uuid.New()
+	now := time.Now()
+
+	valid := jwt.RegisteredClaims{
+		Subject:   player.String(),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+	expired := valid
+	expired.ExpiresAt = jwt.NewNumericDate(now.Add(-time.Minute))
+	noExpiry := valid
+	noExpiry.ExpiresAt = nil
+	notAPlayer := valid
+	notAPlayer.Subject = "admin"
+
+	issued, _, err := a.issue(player)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		ok    bool
+		err   error
+	}{
+		{name: "issued", token: issued, ok: true},
+		{name: "valid", token: sign(t, jwt.SigningMethodHS256, testKey, valid), ok: true},
+		{name: "wrong key", token: sign(t, jwt.SigningMethodHS256, []byte("other-key"), valid)},
+		{name: "alg none", token: sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, valid)},
+		{name: "HS512", token: sign(t, jwt.SigningMethodHS512, testKey, valid)},
+		{name: "expired", token: sign(t, jwt.SigningMethodHS256, testKey, expired)},
+		{name: "no expiry", token: sign(t, jwt.SigningMethodHS256, testKey, noExpiry), err: errNoExpiry},
+		{name: "subject not a player", token: sign(t, jwt.SigningMethodHS256, testKey, notAPlayer)},
+		{name: "not a token", token: "not-a-token"},
+	}
+
+	for _, tt := range tests {
+		got, err := a.verify(tt.token)
+		if tt.ok {
+			if err != nil || got != player {
+				t.Errorf("%s: want player %s, got %s (%v)", tt.name, player, got, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%s: want an error, got player %s", tt.name, got)
+			continue
+		}
+		if tt.err != nil && !errors.Is(err, tt.err) {
+			t.Errorf("%s: want %v, got %v", tt.name, tt.err, err)
+		}
+		if got != uuid.Nil {
+			t.Errorf("%s: want no player, got %s", tt.name, got)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	a := newAuthenticator(testKey, time.Hour)
+	player := uuid.New()
+	token, _, err := a.issue(player)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := newAuthenticator([]byte("other-key"), time.Hour)
+	forged, _, err := other.issue(player)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		status        int
+		challenge     string
+	}{
+		{"valid", "Bearer " + token, http.StatusOK, ""},
+		{"no header", "", http.StatusUnauthorized, "Bearer"},
+		{"no Bearer prefix", token, http.StatusUnauthorized, "Bearer"},
+		{"other scheme", "Basic " + token, http.StatusUnauthorized, "Bearer"},
+		{"invalid token", "Bearer " + forged, http.StatusUnauthorized, `Bearer error="invalid_token"`},
+	}
+
+	for _, tt := range tests {
+		var got uuid.UUID
+		handler := a.middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = playerID(r)
+		}))
+
+		r := httptest.NewRequest(http.MethodGet, "/inventory", nil)
+		if tt.authorization != "" {
+			r.Header.Set("Authorization", tt.authorization)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		if w.Code != tt.status {
+			t.Errorf("%s: want status %d, got %d", tt.name, tt.status, w.Code)
+		}
+		if challenge := w.Header().Get("WWW-Authenticate"); challenge != tt.challenge {
+			t.Errorf("%s: want WWW-Authenticate %q, got %q", tt.name, tt.challenge, challenge)
+		}
+		if tt.status == http.StatusOK && got != player {
+			t.Errorf("%s: want player %s, got %s", tt.name, player, got)
+		}
+		if tt.status != http.StatusOK && got != uuid.Nil {
+			t.Errorf("%s: want the handler not to run, got player %s", tt.name, got)
+		}
+	}
+}
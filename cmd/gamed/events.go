@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/alfreddobradi/actor-game/api"
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// heartbeatInterval is how often an idle stream sends a comment so
+	// proxies do not close the connection
+	heartbeatInterval = 15 * time.Second
+
+	// changeBuffer is how many changes can wait for a slow client before its
+	// stream is closed
+	changeBuffer = 64
+)
+
+// streamInventory sends the changes of the inventory of the player as
+// server-sent events. The stream starts with the whole inventory, with the
+// sequence number of the last event it reflects as the ID, and every event
+// recorded afterwards follows with its own sequence number. Clients that fall
+// behind are disconnected and have to reconnect.
+//
+// Only recorded events are streamed. Production, upkeep and population growth
+// accrue continuously without events, so clients work out the amounts from
+// the rates in the inventory and describe it again for exact amounts or to
+// learn which buildings went inactive.
+func streamInventory(c *cluster.Cluster, w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, shared.ErrorCode_Internal, "streaming is not supported")
+		return
+	}
+
+	inventoryID := shared.GenerateInventoryGrainID(playerID(r)).String()
+	topic := shared.InventoryTopic(inventoryID)
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	changes := make(chan *shared.InventoryChanged, changeBuffer)
+	pid := c.ActorSystem.Root.Spawn(actor.PropsFromFunc(func(actx actor.Context) {
+		change, ok := actx.Message().(*shared.InventoryChanged)
+		if !ok {
+			return
+		}
+		select {
+		case changes <- change:
+		default:
+			cancel()
+		}
+	}))
+	defer c.ActorSystem.Root.Stop(pid)
+
+	if _, err := c.SubscribeByPid(topic, pid); err != nil {
+		log.Printf("failed to subscribe to %s: %v", topic, err)
+		writeError(w, shared.ErrorCode_Internal, http.StatusText(http.StatusInternalServerError))
+		return
+	}
+	defer func() {
+		if _, err := c.UnsubscribeByPid(topic, pid); err != nil {
+			log.Printf("failed to unsubscribe from %s: %v", topic, err)
+		}
+	}()
+
+	// the inventory is described after subscribing so no change is missed
+	client := shared.GetInventoryGrainClient(c, inventoryID)
+	res, err := client.Describe(&shared.DescribeInventoryRequest{Timestamp: timestamppb.Now()})
+	if err != nil {
+		writeError(w, shared.ErrorCode_Internal, http.StatusText(http.StatusInternalServerError))
+		return
+	}
+	if res.GetError() != nil {
+		writeJSON(w, api.HTTPStatus(res.GetError()), api.NewInventoryResponse(res))
+		return
+	}
+
+	inventory, err := json.Marshal(api.NewInventoryResponse(res))
+	if err != nil {
+		log.Printf("json encoding error: %v", err)
+		writeError(w, shared.ErrorCode_Internal, http.StatusText(http.StatusInternalServerError))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// keeps nginx from buffering the stream
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "id: %d\nevent: inventory\ndata: %s\n\n", res.GetSequence(), inventory)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case change := <-changes:
+			// changes recorded before the inventory was described are
+			// already part of it
+			if change.GetSequence() <= res.GetSequence() {
+				continue
+			}
+			fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", change.GetSequence(), change.GetEvent(), change.GetData())
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+		}
+		flusher.Flush()
+	}
+}
@@ -5,17 +5,23 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/alfreddobradi/actor-game/actor/hello"
 	"github.com/alfreddobradi/actor-game/actor/inventory"
+	"github.com/alfreddobradi/actor-game/actor/player"
+	"github.com/alfreddobradi/actor-game/actor/scheduler"
+	"github.com/alfreddobradi/actor-game/actor/timer"
 	"github.com/alfreddobradi/actor-game/api"
+	"github.com/alfreddobradi/actor-game/persistence"
 	"github.com/alfreddobradi/actor-game/registry"
-	"github.com/alfreddobradi/actor-game/shared"
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
 	"github.com/asynkron/protoactor-go/actor"
 	"github.com/asynkron/protoactor-go/cluster"
 	"github.com/asynkron/protoactor-go/cluster/clusterproviders/etcd"
@@ -23,13 +29,15 @@ import (
 	"github.com/asynkron/protoactor-go/remote"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
-	"github.com/google/uuid"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"google.golang.org/grpc"
-	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// maxIdempotencyKeyLength bounds the Idempotency-Key header, since the
+// inventory remembers every key it sees for a day
+const maxIdempotencyKeyLength = 255
+
 func main() {
 	etcdEndpoints := os.Getenv("GAMED_ETCD_ENDPOINTS")
 	if etcdEndpoints == "" {
@@ -39,6 +47,66 @@ func main() {
 	if listeningPort == "" {
 		listeningPort = "80"
 	}
+	persistenceBackend := os.Getenv("GAMED_PERSISTENCE_BACKEND")
+	if persistenceBackend == "" {
+		persistenceBackend = persistence.BackendFile
+	}
+	authKey := os.Getenv("GAMED_AUTH_KEY")
+	if authKey == "" {
+		log.Fatalln("Please set GAMED_AUTH_KEY env var")
+	}
+	// a relative default would land inside the container and be lost with it
+	persistencePath := os.Getenv("GAMED_PERSISTENCE_PATH")
+	if persistencePath == "" && persistenceBackend == persistence.BackendFile {
+		log.Fatalln("Please set GAMED_PERSISTENCE_PATH env var for the file persistence backend")
+	}
+	buildSlots := 1
+	if slots := os.Getenv("GAMED_BUILD_SLOTS"); slots != "" {
+		n, err := strconv.Atoi(slots)
+		if err != nil || n < 1 {
+			log.Fatalf("GAMED_BUILD_SLOTS must be a positive integer, got %q", slots)
+		}
+		buildSlots = n
+	}
+	tokenTTL := 24 * time.Hour
+	if ttl := os.Getenv("GAMED_TOKEN_TTL"); ttl != "" {
+		d, err := time.ParseDuration(ttl)
+		if err != nil || d <= 0 {
+			log.Fatalf("GAMED_TOKEN_TTL must be a positive duration, got %q", ttl)
+		}
+		tokenTTL = d
+	}
+	ipLimit := limit{rate: 20, burst: 40}
+	if spec := os.Getenv("GAMED_RATE_LIMIT_IP"); spec != "" {
+		l, err := parseLimit(spec)
+		if err != nil {
+			log.Fatalf("GAMED_RATE_LIMIT_IP: %v", err)
+		}
+		ipLimit = l
+	}
+	routeLimit := limit{rate: 10, burst: 20}
+	if spec := os.Getenv("GAMED_RATE_LIMIT_ROUTE"); spec != "" {
+		l, err := parseLimit(spec)
+		if err != nil {
+			log.Fatalf("GAMED_RATE_LIMIT_ROUTE: %v", err)
+		}
+		routeLimit = l
+	}
+	routeLimits, err := parseRouteLimits(os.Getenv("GAMED_RATE_LIMITS"))
+	if err != nil {
+		log.Fatalf("GAMED_RATE_LIMITS: %v", err)
+	}
+	trustedProxies, err := parseProxies(os.Getenv("GAMED_TRUSTED_PROXIES"))
+	if err != nil {
+		log.Fatalf("GAMED_TRUSTED_PROXIES: %v", err)
+	}
+
+	// the catalog only replaces the one in etcd if its version is newer
+	if blueprintsPath := os.Getenv("GAMED_BLUEPRINTS_PATH"); blueprintsPath != "" {
+		if err := registry.LoadFile(blueprintsPath); err != nil {
+			log.Fatalf("error loading blueprints: %v", err)
+		}
+	}
 
 	sigchan := make(chan os.Signal, 1)
 	signal.Notify(sigchan, os.Interrupt)
@@ -47,14 +115,33 @@ func main() {
 
 	system := actor.NewActorSystem()
 
-	provider, err := etcd.NewWithConfig("/actor-game", clientv3.Config{
+	etcdConfig := clientv3.Config{
 		Endpoints:   endpoints,
 		DialTimeout: 5 * time.Second,
 		DialOptions: []grpc.DialOption{grpc.WithBlock()},
-	})
+	}
+
+	provider, err := etcd.NewWithConfig("/actor-game", etcdConfig)
 	if err != nil {
 		log.Fatalf("error creating etcd provider: %v", err)
 	}
+
+	etcdClient, err := clientv3.New(etcdConfig)
+	if err != nil {
+		log.Fatalf("error creating etcd client: %v", err)
+	}
+	defer etcdClient.Close()
+
+	syncCtx, stopSync := context.WithCancel(context.Background())
+	defer stopSync()
+	if err := registry.Sync(syncCtx, etcdClient); err != nil {
+		log.Fatalf("error syncing blueprints: %v", err)
+	}
+	backend, err := persistence.New(persistenceBackend, persistencePath)
+	if err != nil {
+		log.Fatalf("error creating persistence backend: %v", err)
+	}
+
 	lookup := disthash.New()
 	config := remote.Configure("localhost", 0)
 
@@ -62,125 +149,281 @@ func main() {
 		return &hello.HelloGrain{}
 	}, 0)
 	inventoryKind := shared.NewInventoryKind(func() shared.Inventory {
-		return &inventory.InventoryGrain{}
+		return inventory.New(backend, inventory.Config{BuildSlots: buildSlots})
+	}, 0)
+	timerKind := shared.NewTimerKind(func() shared.Timer {
+		return &timer.TimerGrain{}
+	}, 0)
+	schedulerKind := shared.NewSchedulerKind(func() shared.Scheduler {
+		return scheduler.New(backend)
+	}, 0)
+
+	playerKind := shared.NewPlayerKind(func() shared.Player {
+		return player.New(backend)
 	}, 0)
 
-	clusterConfig := cluster.Configure("game-cluster", provider, lookup, config, cluster.WithKinds(helloKind, inventoryKind))
+	clusterConfig := cluster.Configure("game-cluster", provider, lookup, config, cluster.WithKinds(helloKind, inventoryKind, timerKind, schedulerKind, playerKind))
 	c := cluster.New(system, clusterConfig)
 	c.StartMember()
 	defer c.Shutdown(true)
 
+	// scheduler shards only fire their persisted jobs while they are active,
+	// so every member keeps poking them in case their owner went away
+	go func() {
+		ticker := time.NewTicker(30 * time.Second)
+		defer ticker.Stop()
+		for {
+			scheduler.Activate(c)
+			<-ticker.C
+		}
+	}()
+
 	r := chi.NewRouter()
 	r.Use(middleware.RequestID)
 	r.Use(middleware.Logger)
 
-	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
-		name := r.URL.Query().Get("name")
-		if name == "" {
-			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
-			return
-		}
-		client := shared.GetHelloGrainClient(c, "mygrain1")
-		res, err := client.SayHello(&shared.HelloRequest{
-			Timestamp: timestamppb.Now(),
-			Context: &structpb.Struct{
-				Fields: map[string]*structpb.Value{
-					"name": structpb.NewStringValue(name),
-				},
-			},
+	auth := newAuthenticator([]byte(authKey), tokenTTL)
+	limits := newRateLimits(r, trustedProxies, ipLimit, routeLimit, routeLimits)
+
+	r.Group(func(r chi.Router) {
+		r.Use(limits.perIP, limits.perRoute)
+
+		r.Get("/", func(w http.ResponseWriter, r *http.Request) {
+			name := r.URL.Query().Get("name")
+			if name == "" {
+				writeError(w, shared.ErrorCode_InvalidRequest, "name is required")
+				return
+			}
+			client := shared.GetHelloGrainClient(c, "mygrain1")
+			res, err := client.SayHello(&shared.HelloRequest{
+				Timestamp: timestamppb.Now(),
+				Name:      name,
+			})
+			if err != nil {
+				writeError(w, shared.ErrorCode_Internal, http.StatusText(http.StatusInternalServerError))
+				return
+			}
+
+			if res.Status != shared.Status_OK {
+				writeJSON(w, api.HTTPStatus(res.Error), api.BuildResponse{
+					Status: res.Status.String(),
+					Error:  api.NewError(res.Error),
+				})
+				return
+			}
+
+			w.Write([]byte(res.Message + "\n"))
 		})
-		if err != nil {
-			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-			return
-		}
 
-		if res.Status != 0 {
-			http.Error(w, res.Context.Fields[hello.KeyError].GetStringValue(), http.StatusInternalServerError)
-			return
-		}
+		r.Get("/blueprints", func(w http.ResponseWriter, r *http.Request) {
+			response := api.BlueprintsResponse{
+				Version:    registry.Version(),
+				Resources:  registry.Resources(),
+				Blueprints: registry.List(),
+			}
 
-		w.Write([]byte(res.Context.Fields[hello.KeyMessage].GetStringValue() + "\n"))
-	})
+			writeJSON(w, http.StatusOK, response)
+		})
 
-	r.Get("/inventory", func(w http.ResponseWriter, r *http.Request) {
-		user := r.Header.Get("X-User-Id")
-		if user == "" {
-			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
-			return
-		}
+		r.Post("/players", func(w http.ResponseWriter, r *http.Request) {
+			request := api.PlayerRequest{}
+			decoder := json.NewDecoder(r.Body)
+			if err := decoder.Decode(&request); err != nil {
+				writeError(w, shared.ErrorCode_InvalidRequest, "invalid request body")
+				return
+			}
 
-		id, err := uuid.Parse(user)
-		if err != nil {
-			log.Printf("uuid parse error: %v", err)
-			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
-			return
-		}
+			client := shared.GetPlayerGrainClient(c, shared.GeneratePlayerID(request.Name).String())
+			res, err := client.Register(&shared.RegisterPlayerRequest{
+				Timestamp: timestamppb.Now(),
+				Name:      request.Name,
+				Password:  request.Password,
+			})
+			if err != nil {
+				writeError(w, shared.ErrorCode_Internal, http.StatusText(http.StatusInternalServerError))
+				return
+			}
 
-		inventoryID := shared.GenerateInventoryGrainID(id)
-		client := shared.GetInventoryGrainClient(c, inventoryID.String())
-		res, err := client.Describe(&shared.DescribeInventoryRequest{Timestamp: timestamppb.Now()})
-		if err != nil {
-			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-			return
-		}
+			writeSession(w, auth, res)
+		})
 
-		context := res.Context.AsMap()
-		w.Header().Set("Content-Type", "application/json")
-		encoder := json.NewEncoder(w)
-		if err := encoder.Encode(context); err != nil {
-			log.Printf("json encoding error: %v", err)
-		}
+		r.Post("/sessions", func(w http.ResponseWriter, r *http.Request) {
+			request := api.PlayerRequest{}
+			decoder := json.NewDecoder(r.Body)
+			if err := decoder.Decode(&request); err != nil {
+				writeError(w, shared.ErrorCode_InvalidRequest, "invalid request body")
+				return
+			}
+
+			client := shared.GetPlayerGrainClient(c, shared.GeneratePlayerID(request.Name).String())
+			res, err := client.Login(&shared.LoginRequest{
+				Timestamp: timestamppb.Now(),
+				Password:  request.Password,
+			})
+			if err != nil {
+				writeError(w, shared.ErrorCode_Internal, http.StatusText(http.StatusInternalServerError))
+				return
+			}
+
+			writeSession(w, auth, res)
+		})
 	})
+	r.Group(func(r chi.Router) {
+		// addresses are limited before authentication so guessing tokens
+		// is throttled as well
+		r.Use(limits.perIP, auth.middleware, limits.perRoute)
 
-	r.Post("/inventory/building", func(w http.ResponseWriter, r *http.Request) {
-		user := r.Header.Get("X-User-Id")
-		if user == "" {
-			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
-			return
-		}
+		r.Get("/inventory", func(w http.ResponseWriter, r *http.Request) {
+			inventoryID := shared.GenerateInventoryGrainID(playerID(r))
+			client := shared.GetInventoryGrainClient(c, inventoryID.String())
+			res, err := client.Describe(&shared.DescribeInventoryRequest{Timestamp: timestamppb.Now()})
+			if err != nil {
+				writeError(w, shared.ErrorCode_Internal, http.StatusText(http.StatusInternalServerError))
+				return
+			}
 
-		id, err := uuid.Parse(user)
-		if err != nil {
-			log.Printf("uuid parse error: %v", err)
-			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
-			return
-		}
+			writeJSON(w, api.HTTPStatus(res.GetError()), api.NewInventoryResponse(res))
+		})
 
-		request := api.BuildRequest{}
-		decoder := json.NewDecoder(r.Body)
-		if err := decoder.Decode(&request); err != nil {
-			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
-			return
-		}
+		r.Get("/inventory/blueprints", func(w http.ResponseWriter, r *http.Request) {
+			inventoryID := shared.GenerateInventoryGrainID(playerID(r))
+			client := shared.GetInventoryGrainClient(c, inventoryID.String())
+			res, err := client.ListBlueprints(&shared.ListBlueprintsRequest{Timestamp: timestamppb.Now()})
+			if err != nil {
+				writeError(w, shared.ErrorCode_Internal, http.StatusText(http.StatusInternalServerError))
+				return
+			}
 
-		if !registry.IsValidBlueprint(request.Blueprint) {
-			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
-			return
-		}
+			writeJSON(w, api.HTTPStatus(res.GetError()), api.NewInventoryBlueprintsResponse(res))
+		})
 
-		buildpb := &shared.BuildRequest{
-			Timestamp: timestamppb.Now(),
-			Context: &structpb.Struct{
-				Fields: map[string]*structpb.Value{
-					inventory.KeyBlueprint: structpb.NewStringValue(request.Blueprint),
-				},
-			},
-		}
+		r.Get("/inventory/events", func(w http.ResponseWriter, r *http.Request) {
+			streamInventory(c, w, r)
+		})
 
-		inventoryID := shared.GenerateInventoryGrainID(id)
-		client := shared.GetInventoryGrainClient(c, inventoryID.String())
-		res, err := client.StartBuild(buildpb)
-		if err != nil {
-			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-			return
-		}
+		r.Post("/inventory/building", func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get("Idempotency-Key")
+			if len(key) > maxIdempotencyKeyLength {
+				writeError(w, shared.ErrorCode_InvalidRequest, "idempotency key is too long")
+				return
+			}
 
-		context := res.Context.AsMap()
-		w.Header().Set("Content-Type", "application/json")
-		encoder := json.NewEncoder(w)
-		if err := encoder.Encode(context); err != nil {
-			log.Printf("json encoding error: %v", err)
-		}
+			request := api.BuildRequest{}
+			decoder := json.NewDecoder(r.Body)
+			if err := decoder.Decode(&request); err != nil {
+				writeError(w, shared.ErrorCode_InvalidRequest, "invalid request body")
+				return
+			}
+
+			if err := registry.Validate(request.Blueprint); err != nil {
+				writeError(w, shared.ErrorCode_BlueprintNotFound, "requested blueprint not found")
+				return
+			}
+
+			buildpb := &shared.BuildRequest{
+				Timestamp:      timestamppb.Now(),
+				Blueprint:      request.Blueprint,
+				IdempotencyKey: key,
+			}
+
+			inventoryID := shared.GenerateInventoryGrainID(playerID(r))
+			client := shared.GetInventoryGrainClient(c, inventoryID.String())
+			res, err := client.StartBuild(buildpb)
+			if err != nil {
+				writeError(w, shared.ErrorCode_Internal, http.StatusText(http.StatusInternalServerError))
+				return
+			}
+
+			writeJSON(w, api.HTTPStatus(res.GetError()), api.NewBuildResponse(res))
+		})
+
+		r.Delete("/inventory/building/{id}", func(w http.ResponseWriter, r *http.Request) {
+			cancelpb := &shared.CancelBuildRequest{
+				Timestamp:    timestamppb.Now(),
+				Construction: chi.URLParam(r, "id"),
+			}
+
+			inventoryID := shared.GenerateInventoryGrainID(playerID(r))
+			client := shared.GetInventoryGrainClient(c, inventoryID.String())
+			res, err := client.CancelBuild(cancelpb)
+			if err != nil {
+				writeError(w, shared.ErrorCode_Internal, http.StatusText(http.StatusInternalServerError))
+				return
+			}
+
+			writeJSON(w, api.HTTPStatus(res.GetError()), api.NewCancelBuildResponse(res))
+		})
+
+		r.Post("/inventory/building/{id}/upgrade", func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get("Idempotency-Key")
+			if len(key) > maxIdempotencyKeyLength {
+				writeError(w, shared.ErrorCode_InvalidRequest, "idempotency key is too long")
+				return
+			}
+
+			upgradepb := &shared.UpgradeBuildingRequest{
+				Timestamp:      timestamppb.Now(),
+				Building:       chi.URLParam(r, "id"),
+				IdempotencyKey: key,
+			}
+
+			inventoryID := shared.GenerateInventoryGrainID(playerID(r))
+			client := shared.GetInventoryGrainClient(c, inventoryID.String())
+			res, err := client.UpgradeBuilding(upgradepb)
+			if err != nil {
+				writeError(w, shared.ErrorCode_Internal, http.StatusText(http.StatusInternalServerError))
+				return
+			}
+
+			writeJSON(w, api.HTTPStatus(res.GetError()), api.NewBuildResponse(res))
+		})
+
+		r.Post("/inventory/building/{id}/demolish", func(w http.ResponseWriter, r *http.Request) {
+			demolishpb := &shared.DemolishRequest{
+				Timestamp: timestamppb.Now(),
+				Building:  chi.URLParam(r, "id"),
+			}
+
+			inventoryID := shared.GenerateInventoryGrainID(playerID(r))
+			client := shared.GetInventoryGrainClient(c, inventoryID.String())
+			res, err := client.Demolish(demolishpb)
+			if err != nil {
+				writeError(w, shared.ErrorCode_Internal, http.StatusText(http.StatusInternalServerError))
+				return
+			}
+
+			writeJSON(w, api.HTTPStatus(res.GetError()), api.NewDemolishResponse(res))
+		})
+
+		r.Post("/inventory/workers", func(w http.ResponseWriter, r *http.Request) {
+			request := api.AssignWorkersRequest{}
+			decoder := json.NewDecoder(r.Body)
+			if err := decoder.Decode(&request); err != nil {
+				writeError(w, shared.ErrorCode_InvalidRequest, "invalid request body")
+				return
+			}
+
+			if err := registry.Validate(request.Blueprint); err != nil {
+				writeError(w, shared.ErrorCode_BlueprintNotFound, "requested blueprint not found")
+				return
+			}
+
+			assignpb := &shared.AssignWorkersRequest{
+				Timestamp: timestamppb.Now(),
+				Blueprint: request.Blueprint,
+				Workers:   request.Workers,
+			}
+
+			inventoryID := shared.GenerateInventoryGrainID(playerID(r))
+			client := shared.GetInventoryGrainClient(c, inventoryID.String())
+			res, err := client.AssignWorkers(assignpb)
+			if err != nil {
+				writeError(w, shared.ErrorCode_Internal, http.StatusText(http.StatusInternalServerError))
+				return
+			}
+
+			writeJSON(w, api.HTTPStatus(res.GetError()), api.NewAssignWorkersResponse(res))
+		})
 	})
 
 	r.Get("/healthz", func(w http.ResponseWriter, r *http.Request) {
@@ -188,10 +431,15 @@ func main() {
 	})
 
 	listenAddress := fmt.Sprintf("0.0.0.0:%s", listeningPort)
+	// event streams never finish on their own, so the contexts of all
+	// requests are cancelled when the server shuts down
+	requests, cancelRequests := context.WithCancel(context.Background())
 	s := &http.Server{
-		Addr:    listenAddress,
-		Handler: r,
+		Addr:        listenAddress,
+		Handler:     r,
+		BaseContext: func(net.Listener) context.Context { return requests },
 	}
+	s.RegisterOnShutdown(cancelRequests)
 
 	go func() {
 		if err := s.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -205,3 +453,18 @@ func main() {
 	<-sigchan
 
 }
+
+// writeJSON sends the response encoded as JSON with the given status code
+func writeJSON(w http.ResponseWriter, status int, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	encoder := json.NewEncoder(w)
+	if err := encoder.Encode(response); err != nil {
+		log.Printf("json encoding error: %v", err)
+	}
+}
+
+// writeError refuses a request before it reaches a grain
+func writeError(w http.ResponseWriter, code shared.ErrorCode, message string) {
+	writeJSON(w, api.HTTPStatus(shared.Failure(code, message)), api.ErrorResponse(code, message))
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	shared "github.com/alfreddobradi/actor-game/shared/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// sweepInterval is how often buckets that refilled completely are dropped
+const sweepInterval = time.Minute
+
+// limit is the number of requests per second a client can sustain and how
+// many it can make at once
+type limit struct {
+	rate  float64
+	burst float64
+}
+
+// parseLimit reads a limit written as <requests>/<s|m|h>[:<burst>], such as
+// 10/s:20. The burst defaults to the number of requests.
+func parseLimit(s string) (limit, error) {
+	spec, burstSpec, hasBurst := strings.Cut(strings.TrimSpace(s), ":")
+	count, unit, ok := strings.Cut(spec, "/")
+	if !ok {
+		return limit{}, fmt.Errorf("invalid rate limit %q", s)
+	}
+
+	n, err := strconv.ParseFloat(count, 64)
+	if err != nil || n <= 0 {
+		return limit{}, fmt.Errorf("invalid rate limit %q", s)
+	}
+
+	var per time.Duration
+	switch unit {
+	case "s":
+		per = time.Second
+	case "m":
+		per = time.Minute
+	case "h":
+		per = time.Hour
+	default:
+		return limit{}, fmt.Errorf("invalid rate limit unit in %q", s)
+	}
+
+	l := limit{rate: n / per.Seconds(), burst: math.Max(1, math.Ceil(n))}
+	if hasBurst {
+		burst, err := strconv.ParseFloat(burstSpec, 64)
+		if err != nil || burst < 1 {
+			return limit{}, fmt.Errorf("invalid rate limit burst in %q", s)
+		}
+		l.burst = burst
+	}
+	return l, nil
+}
+
+// parseRouteLimits reads per route limits written as <route>=<limit> pairs
+// separated by semicolons, where route is a method and a route pattern, such
+// as "POST /inventory/building=1/s:5;POST /sessions=5/m".
+func parseRouteLimits(s string) (map[string]limit, error) {
+	limits := make(map[string]limit)
+	for _, entry := range strings.Split(s, ";") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		route, spec, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid route limit %q", entry)
+		}
+		l, err := parseLimit(spec)
+		if err != nil {
+			return nil, err
+		}
+		limits[strings.Join(strings.Fields(route), " ")] = l
+	}
+	return limits, nil
+}
+
+// parseProxies reads a comma separated list of proxy addresses, each a CIDR
+// range or a single IP address, such as "10.0.0.0/8,192.168.1.10"
+func parseProxies(s string) ([]*net.IPNet, error) {
+	var proxies []*net.IPNet
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid proxy address %q", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy range %q", entry)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
+// bucket holds the tokens of one client. Each token allows one request and
+// they are refilled continuously up to the burst of the limit.
+type bucket struct {
+	limit   limit
+	tokens  float64
+	updated time.Time
+}
+
+// limiter is a set of token buckets, one per key
+type limiter struct {
+	mx      sync.Mutex
+	buckets map[string]*bucket
+	swept   time.Time
+}
+
+func newLimiter() *limiter {
+	return &limiter{buckets: make(map[string]*bucket)}
+}
+
+// take removes a token from the bucket of the key. If the bucket is empty it
+// returns how long until the next token is available instead.
+func (l *limiter) take(key string, lim limit, now time.Time) (time.Duration, bool) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if now.Sub(l.swept) > sweepInterval {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limit: lim, tokens: lim.burst, updated: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = math.Min(lim.burst, b.tokens+now.Sub(b.updated).Seconds()*lim.rate)
+	b.updated = now
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) / lim.rate * float64(time.Second)), false
+	}
+
+	b.tokens--
+	return 0, true
+}
+
+// sweep drops the buckets that would be full by now, as they are no different
+// from a new bucket
+func (l *limiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		full := time.Duration((b.limit.burst - b.tokens) / b.limit.rate * float64(time.Second))
+		if now.Sub(b.updated) >= full {
+			delete(l.buckets, key)
+		}
+	}
+	l.swept = now
+}
+
+// rateLimits throttles clients of the HTTP API. Every IP address has a budget
+// shared by all routes, and every client has a budget per route. Clients are
+// identified by the authenticated player or by their address otherwise.
+type rateLimits struct {
+	router  *chi.Mux
+	proxies []*net.IPNet
+
+	ip     limit
+	route  limit
+	routes map[string]limit
+
+	byIP     *limiter
+	byClient *limiter
+}
+
+func newRateLimits(router *chi.Mux, proxies []*net.IPNet, ip, route limit, routes map[string]limit) *rateLimits {
+	return &rateLimits{
+		router:   router,
+		proxies:  proxies,
+		ip:       ip,
+		route:    route,
+		routes:   routes,
+		byIP:     newLimiter(),
+		byClient: newLimiter(),
+	}
+}
+
+// perIP limits every request by the address it came from
+func (rl *rateLimits) perIP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if wait, ok := rl.byIP.take(rl.clientIP(r), rl.ip, time.Now()); !ok {
+			tooManyRequests(w, wait)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// perRoute limits requests to a route by the player making them, or by their
+// address if they are not authenticated. It has to run after authentication.
+func (rl *rateLimits) perRoute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.Method + " " + r.URL.Path
+		if rctx := chi.NewRouteContext(); rl.router.Match(rctx, r.Method, r.URL.Path) {
+			route = r.Method + " " + rctx.RoutePattern()
+		}
+
+		lim, ok := rl.routes[route]
+		if !ok {
+			lim = rl.route
+		}
+
+		client := rl.clientIP(r)
+		if player := playerID(r); player != uuid.Nil {
+			client = player.String()
+		}
+
+		if wait, ok := rl.byClient.take(route+" "+client, lim, time.Now()); !ok {
+			tooManyRequests(w, wait)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// clientIP returns the address a request came from. Requests from trusted
+// proxies are followed back through X-Forwarded-For from the right, as only
+// the hops our proxies appended can be believed; anything further left was
+// sent by the client. Other headers naming the client are ignored.
+func (rl *rateLimits) clientIP(r *http.Request) string {
+	addr, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		addr = r.RemoteAddr
+	}
+	if !rl.trusted(net.ParseIP(addr)) {
+		return addr
+	}
+
+	var hops []string
+	for _, header := range r.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(header, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		ip := net.ParseIP(strings.TrimSpace(hops[i]))
+		if ip == nil {
+			break
+		}
+		addr = ip.String()
+		if !rl.trusted(ip) {
+			break
+		}
+	}
+	return addr
+}
+
+// trusted reports whether ip belongs to one of the trusted proxies
+func (rl *rateLimits) trusted(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, proxy := range rl.proxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// tooManyRequests refuses a request, telling the client when to retry in
+// whole seconds
+func tooManyRequests(w http.ResponseWriter, wait time.Duration) {
+	seconds := int(math.Ceil(wait.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	writeError(w, shared.ErrorCode_RateLimited, "too many requests")
+}
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		spec string
+		want limit
+		err  bool
+	}{
+		{spec: "10/s", want: limit{rate: 10, burst: 10}},
+		{spec: "10/s:20", want: limit{rate: 10, burst: 20}},
+		{spec: "120/m", want: limit{rate: 2, burst: 120}},
+		{spec: "3600/h:1", want: limit{rate: 1, burst: 1}},
+		{spec: " 0.5/s ", want: limit{rate: 0.5, burst: 1}},
+		{spec: "10", err: true},
+		{spec: "10/d", err: true},
+		{spec: "ten/s", err: true},
+		{spec: "0/s", err: true},
+		{spec: "-1/s", err: true},
+		{spec: "10/s:0", err: true},
+		{spec: "10/s:many", err: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseLimit(tt.spec)
+		if tt.err {
+			if err == nil {
+				t.Errorf("%q: want an error, got %+v", tt.spec, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", tt.spec, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q: want %+v, got %+v", tt.spec, tt.want, got)
+		}
+	}
+}
+
+func TestParseRouteLimits(t *testing.T) {
+	tests := []struct {
+		spec string
+		want map[string]limit
+		err  bool
+	}{
+		{spec: "", want: map[string]limit{}},
+		{
+			spec: "POST /inventory/building=1/s:5;POST /sessions=5/m",
+			want: map[string]limit{
+				"POST /inventory/building": {rate: 1, burst: 5},
+				"POST /sessions":           {rate: 5.0 / 60, burst: 5},
+			},
+		},
+		{
+			spec: "  GET   /inventory = 2/s ; ;",
+			want: map[string]limit{"GET /inventory": {rate: 2, burst: 2}},
+		},
+		{spec: "POST /sessions", err: true},
+		{spec: "POST /sessions=5/d", err: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseRouteLimits(tt.spec)
+		if tt.err {
+			if err == nil {
+				t.Errorf("%q: want an error, got %+v", tt.spec, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", tt.spec, err)
+			continue
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%q: want %+v, got %+v", tt.spec, tt.want, got)
+			continue
+		}
+		for route, want := range tt.want {
+			if got[route] != want {
+				t.Errorf("%q: want %+v for %q, got %+v", tt.spec, want, route, got[route])
+			}
+		}
+	}
+}
+
+func TestLimiterTake(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	lim := limit{rate: 2, burst: 3}
+
+	tests := []struct {
+		name string
+		at   time.Duration
+		ok   bool
+		wait time.Duration
+	}{
+		{"burst 1", 0, true, 0},
+		{"burst 2", 0, true, 0},
+		{"burst 3", 0, true, 0},
+		{"ran out", 0, false, 500 * time.Millisecond},
+		{"still out", 250 * time.Millisecond, false, 250 * time.Millisecond},
+		{"refilled one", 500 * time.Millisecond, true, 0},
+		{"out again", 500 * time.Millisecond, false, 500 * time.Millisecond},
+		{"refilled to burst", 10 * time.Second, true, 0},
+		{"burst 2 after refill", 10 * time.Second, true, 0},
+		{"burst 3 after refill", 10 * time.Second, true, 0},
+		{"out after refill", 10 * time.Second, false, 500 * time.Millisecond},
+	}
+
+	l := newLimiter()
+	for _, tt := range tests {
+		wait, ok := l.take("a", lim, start.Add(tt.at))
+		if ok != tt.ok || wait != tt.wait {
+			t.Errorf("%s: want %v and a wait of %s, got %v and %s", tt.name, tt.ok, tt.wait, ok, wait)
+		}
+	}
+
+	// keys have buckets of their own
+	if _, ok := l.take("b", lim, start.Add(10*time.Second)); !ok {
+		t.Error("another key: want a token")
+	}
+}
+
+func TestLimiterSweep(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	l := newLimiter()
+	l.take("fast", limit{rate: 1, burst: 1}, start)
+	l.take("slow", limit{rate: 1.0 / 3600, burst: 1}, start)
+	l.take("unused", limit{rate: 1, burst: 2}, start)
+
+	tests := []struct {
+		name string
+		at   time.Duration
+		want []string
+	}{
+		{"nothing refilled", 0, []string{"fast", "slow", "unused"}},
+		{"fast refilled", time.Second, []string{"slow"}},
+		{"slow refilled", 2 * time.Hour, []string{}},
+	}
+
+	for _, tt := range tests {
+		l.sweep(start.Add(tt.at))
+		if len(l.buckets) != len(tt.want) {
+			t.Errorf("%s: want buckets %v, got %d", tt.name, tt.want, len(l.buckets))
+		}
+		for _, key := range tt.want {
+			if _, ok := l.buckets[key]; !ok {
+				t.Errorf("%s: want bucket %q to be kept", tt.name, key)
+			}
+		}
+	}
+
+	// take sweeps once the interval has passed
+	l = newLimiter()
+	l.take("fast", limit{rate: 1, burst: 1}, start)
+	l.take("other", limit{rate: 1, burst: 1}, start.Add(sweepInterval+time.Second))
+	if _, ok := l.buckets["fast"]; ok {
+		t.Error("want take to sweep the refilled bucket")
+	}
+}
+
+func TestTooManyRequests(t *testing.T) {
+	tests := []struct {
+		wait time.Duration
+		want string
+	}{
+		{0, "1"},
+		{100 * time.Millisecond, "1"},
+		{time.Second, "1"},
+		{1500 * time.Millisecond, "2"},
+		{time.Minute, "60"},
+	}
+
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		tooManyRequests(w, tt.wait)
+		if w.Code != http.StatusTooManyRequests {
+			t.Errorf("%s: want status 429, got %d", tt.wait, w.Code)
+		}
+		if got := w.Header().Get("Retry-After"); got != tt.want {
+			t.Errorf("%s: want Retry-After %s, got %s", tt.wait, tt.want, got)
+		}
+	}
+}
+
+func TestParseProxies(t *testing.T) {
+	tests := []struct {
+		spec string
+		want []string
+		err  bool
+	}{
+		{spec: "", want: nil},
+		{spec: "10.0.0.0/8", want: []string{"10.0.0.0/8"}},
+		{spec: " 10.0.0.0/8 , 192.168.1.10,", want: []string{"10.0.0.0/8", "192.168.1.10/32"}},
+		{spec: "fd00::/8,::1", want: []string{"fd00::/8", "::1/128"}},
+		{spec: "10.0.0.0/33", err: true},
+		{spec: "proxy", err: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseProxies(tt.spec)
+		if tt.err {
+			if err == nil {
+				t.Errorf("%q: want an error, got %v", tt.spec, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", tt.spec, err)
+			continue
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%q: want %v, got %v", tt.spec, tt.want, got)
+			continue
+		}
+		for i, want := range tt.want {
+			if got[i].String() != want {
+				t.Errorf("%q: want %v, got %v", tt.spec, tt.want, got)
+			}
+		}
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	proxies, err := parseProxies("10.0.0.0/8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rl := newRateLimits(nil, proxies, limit{}, limit{}, nil)
+
+	tests := []struct {
+		name    string
+		remote  string
+		headers map[string][]string
+		want    string
+	}{
+		{name: "direct", remote: "203.0.113.7:1234", want: "203.0.113.7"},
+		{
+			name:    "forwarded by a client",
+			remote:  "203.0.113.7:1234",
+			headers: map[string][]string{"X-Forwarded-For": {"198.51.100.1"}},
+			want:    "203.0.113.7",
+		},
+		{
+			name:    "forwarded by a proxy",
+			remote:  "10.0.0.5:1234",
+			headers: map[string][]string{"X-Forwarded-For": {"203.0.113.7"}},
+			want:    "203.0.113.7",
+		},
+		{
+			name:    "spoofed hop left of the proxy",
+			remote:  "10.0.0.5:1234",
+			headers: map[string][]string{"X-Forwarded-For": {"198.51.100.1, 203.0.113.7"}},
+			want:    "203.0.113.7",
+		},
+		{
+			name:    "chain of proxies",
+			remote:  "10.0.0.5:1234",
+			headers: map[string][]string{"X-Forwarded-For": {"198.51.100.1, 203.0.113.7, 10.1.0.1"}},
+			want:    "203.0.113.7",
+		},
+		{
+			name:    "hops in separate headers",
+			remote:  "10.0.0.5:1234",
+			headers: map[string][]string{"X-Forwarded-For": {"198.51.100.1", "203.0.113.7"}},
+			want:    "203.0.113.7",
+		},
+		{
+			name:   "other headers",
+			remote: "10.0.0.5:1234",
+			headers: map[string][]string{
+				"X-Forwarded-For": {"203.0.113.7"},
+				"X-Real-Ip":       {"198.51.100.1"},
+				"True-Client-Ip":  {"198.51.100.2"},
+			},
+			want: "203.0.113.7",
+		},
+		{
+			name:    "garbage from a client",
+			remote:  "10.0.0.5:1234",
+			headers: map[string][]string{"X-Forwarded-For": {"unknown, 10.1.0.1"}},
+			want:    "10.1.0.1",
+		},
+		{name: "proxy without the header", remote: "10.0.0.5:1234", want: "10.0.0.5"},
+	}
+
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = tt.remote
+		for name, values := range tt.headers {
+			r.Header[name] = values
+		}
+		if got := rl.clientIP(r); got != tt.want {
+			t.Errorf("%s: want %s, got %s", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestPerIPIgnoresSpoofedHeaders(t *testing.T) {
+	proxies, err := parseProxies("10.0.0.0/8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rl := newRateLimits(nil, proxies, limit{rate: 1.0 / 3600, burst: 1}, limit{}, nil)
+	handler := rl.perIP(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	spoofed := []map[string]string{
+		{"X-Forwarded-For": "203.0.113.7"},
+		{"X-Forwarded-For": "198.51.100.1, 203.0.113.7"},
+		{"X-Forwarded-For": "203.0.113.7", "X-Real-Ip": "198.51.100.2"},
+		{"X-Forwarded-For": "203.0.113.7", "True-Client-Ip": "198.51.100.3"},
+	}
+
+	for i, headers := range spoofed {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = "10.0.0.5:1234"
+		for name, value := range headers {
+			r.Header.Set(name, value)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		want := http.StatusTooManyRequests
+		if i == 0 {
+			want = http.StatusOK
+		}
+		if w.Code != want {
+			t.Errorf("%v: want status %d, got %d", headers, want, w.Code)
+		}
+	}
+}
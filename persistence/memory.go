@@ -0,0 +1,70 @@
+package persistence
+
+import (
+	"sync"
+)
+
+type MemoryBackend struct {
+	mx *sync.RWMutex
+
+	store  map[string][]byte
+	events map[string][]Event
+}
+
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{
+		mx:     &sync.RWMutex{},
+		store:  make(map[string][]byte),
+		events: make(map[string][]Event),
+	}
+}
+
+func (m *MemoryBackend) Load(kind, id string) ([]byte, error) {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	data, ok := m.store[key(kind, id)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	cp := make([]byte, len(data))
+	copy(cp, data)
+	return cp, nil
+}
+
+func (m *MemoryBackend) Save(kind, id string, data []byte) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	cp := make([]byte, len(data))
+	copy(cp, data)
+	m.store[key(kind, id)] = cp
+	return nil
+}
+
+func (m *MemoryBackend) Append(kind, id string, events ...Event) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	k := key(kind, id)
+	m.events[k] = append(m.events[k], events...)
+	return nil
+}
+
+func (m *MemoryBackend) Events(kind, id string, after uint64) ([]Event, error) {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	events := make([]Event, 0)
+	for _, e := range m.events[key(kind, id)] {
+		if e.Sequence > after {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func key(kind, id string) string {
+	return kind + "/" + id
+}
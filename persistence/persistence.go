@@ -0,0 +1,47 @@
+package persistence
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	BackendMemory string = "memory"
+	BackendFile   string = "file"
+)
+
+var ErrNotFound = errors.New("record not found")
+
+// Event is a single entry in a grain's append-only event log. Sequence
+// numbers start at 1 and increase by one with every appended event.
+type Event struct {
+	Sequence  uint64          `json:"seq"`
+	Type      string          `json:"type"`
+	Timestamp time.Time       `json:"ts"`
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+// Backend stores grain state keyed by grain kind and identity. Load and Save
+// handle snapshots, Append and Events handle the event log.
+type Backend interface {
+	Load(kind, id string) ([]byte, error)
+	Save(kind, id string, data []byte) error
+
+	Append(kind, id string, events ...Event) error
+	Events(kind, id string, after uint64) ([]Event, error)
+}
+
+// New returns the backend identified by name. path is only used by
+// backends that write to disk.
+func New(name, path string) (Backend, error) {
+	switch name {
+	case BackendMemory:
+		return NewMemoryBackend(), nil
+	case BackendFile:
+		return NewFileBackend(path)
+	default:
+		return nil, fmt.Errorf("unknown persistence backend: %s", name)
+	}
+}
@@ -0,0 +1,147 @@
+package persistence
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func backends(t *testing.T) map[string]Backend {
+	t.Helper()
+
+	file, err := NewFileBackend(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string]Backend{
+		BackendMemory: NewMemoryBackend(),
+		BackendFile:   file,
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	for name, backend := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			if _, err := backend.Load("inventory", "a"); !errors.Is(err, ErrNotFound) {
+				t.Fatalf("loading a missing record: want ErrNotFound, got %v", err)
+			}
+
+			for _, data := range [][]byte{[]byte(`{"v":1}`), []byte(`{"v":2}`)} {
+				if err := backend.Save("inventory", "a", data); err != nil {
+					t.Fatal(err)
+				}
+				loaded, err := backend.Load("inventory", "a")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(loaded, data) {
+					t.Errorf("want %s, got %s", data, loaded)
+				}
+			}
+
+			// records are kept apart by kind and identity
+			if _, err := backend.Load("inventory", "b"); !errors.Is(err, ErrNotFound) {
+				t.Errorf("loading another identity: want ErrNotFound, got %v", err)
+			}
+			if _, err := backend.Load("player", "a"); !errors.Is(err, ErrNotFound) {
+				t.Errorf("loading another kind: want ErrNotFound, got %v", err)
+			}
+		})
+	}
+}
+
+func TestEvents(t *testing.T) {
+	at := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := make([]Event, 0, 5)
+	for i := uint64(1); i <= 5; i++ {
+		events = append(events, Event{
+			Sequence:  i,
+			Type:      "test",
+			Timestamp: at.Add(time.Duration(i) * time.Second),
+			Data:      json.RawMessage(`{"n":1}`),
+		})
+	}
+
+	tests := []struct {
+		name  string
+		after uint64
+		want  []uint64
+	}{
+		{"all", 0, []uint64{1, 2, 3, 4, 5}},
+		{"after snapshot", 3, []uint64{4, 5}},
+		{"after last", 5, []uint64{}},
+		{"after end", 10, []uint64{}},
+	}
+
+	for name, backend := range backends(t) {
+		t.Run(name, func(t *testing.T) {
+			empty, err := backend.Events("inventory", "a", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(empty) != 0 {
+				t.Fatalf("want no events before appending, got %d", len(empty))
+			}
+
+			// appended one by one and in a batch
+			if err := backend.Append("inventory", "a", events[0]); err != nil {
+				t.Fatal(err)
+			}
+			if err := backend.Append("inventory", "a", events[1:]...); err != nil {
+				t.Fatal(err)
+			}
+			if err := backend.Append("inventory", "b", events[0]); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, tt := range tests {
+				got, err := backend.Events("inventory", "a", tt.after)
+				if err != nil {
+					t.Fatal(err)
+				}
+				sequences := make([]uint64, 0, len(got))
+				for _, e := range got {
+					sequences = append(sequences, e.Sequence)
+				}
+				if !equal(sequences, tt.want) {
+					t.Errorf("%s: want %v, got %v", tt.name, tt.want, sequences)
+				}
+			}
+
+			got, err := backend.Events("inventory", "a", 4)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != 1 || got[0].Type != "test" || !got[0].Timestamp.Equal(events[4].Timestamp) || string(got[0].Data) != `{"n":1}` {
+				t.Errorf("want %+v, got %+v", events[4], got)
+			}
+		})
+	}
+}
+
+func TestFileBackendRejectsUnsafeKeys(t *testing.T) {
+	backend, err := NewFileBackend(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{"", ".", "..", "../a", `a\b`} {
+		if err := backend.Save("inventory", id, []byte("{}")); err == nil {
+			t.Errorf("saving %q: want an error", id)
+		}
+	}
+}
+
+func equal(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,156 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// EtcdKey is where the cluster-wide blueprint catalog is stored
+const EtcdKey string = "/actor-game/blueprints"
+
+// Sync makes etcd the source of truth for blueprints. The active catalog is
+// published if etcd has none yet or an older version. The stored catalog is
+// then loaded and every later change to the key is applied until ctx is
+// cancelled. Invalid catalogs are logged and ignored so a bad edit never
+// empties the registry.
+func Sync(ctx context.Context, client *clientv3.Client) error {
+	if err := publish(ctx, client); err != nil {
+		return fmt.Errorf("publishing blueprint catalog: %w", err)
+	}
+
+	rev, err := fetch(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for ctx.Err() == nil {
+			rev = watch(ctx, client, rev)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+
+			if latest, err := fetch(ctx, client); err != nil {
+				log.Printf("failed to reload blueprints: %v", err)
+			} else {
+				rev = latest
+			}
+		}
+	}()
+
+	return nil
+}
+
+// publish stores the active catalog unless etcd holds the same or a newer
+// version. The write only succeeds if the key did not change since it was
+// compared, otherwise the comparison is repeated.
+func publish(ctx context.Context, client *clientv3.Client) error {
+	local := blueprints.Load()
+
+	for {
+		res, err := client.Get(ctx, EtcdKey)
+		if err != nil {
+			return err
+		}
+
+		unchanged := clientv3.Compare(clientv3.CreateRevision(EtcdKey), "=", 0)
+		if len(res.Kvs) > 0 {
+			kv := res.Kvs[0]
+			stored, err := Parse(bytes.NewReader(kv.Value))
+			switch {
+			case err != nil:
+				log.Printf("blueprint catalog in etcd is invalid, replacing it with version %d: %v", local.version, err)
+			case stored.version > local.version:
+				log.Printf("local blueprint catalog version %d is older than version %d in etcd, using the one in etcd", local.version, stored.version)
+				return nil
+			case stored.version == local.version:
+				if !bytes.Equal(kv.Value, local.source) {
+					log.Printf("local blueprint catalog differs from the one in etcd but both are version %d, using the one in etcd; raise the version to publish it", local.version)
+				}
+				return nil
+			default:
+				log.Printf("replacing blueprint catalog version %d in etcd with version %d", stored.version, local.version)
+			}
+			unchanged = clientv3.Compare(clientv3.ModRevision(EtcdKey), "=", kv.ModRevision)
+		}
+
+		txn, err := client.Txn(ctx).
+			If(unchanged).
+			Then(clientv3.OpPut(EtcdKey, string(local.source))).
+			Commit()
+		if err != nil {
+			return err
+		}
+		if txn.Succeeded {
+			return nil
+		}
+		// another member wrote the catalog in the meantime
+	}
+}
+
+// fetch loads the stored catalog and returns the revision it was read at
+func fetch(ctx context.Context, client *clientv3.Client) (int64, error) {
+	res, err := client.Get(ctx, EtcdKey)
+	if err != nil {
+		return 0, fmt.Errorf("fetching blueprint catalog: %w", err)
+	}
+
+	for _, kv := range res.Kvs {
+		if err := apply(kv.Value, kv.ModRevision); err != nil {
+			return 0, err
+		}
+	}
+
+	return res.Header.Revision, nil
+}
+
+// watch applies changes after rev until the watch breaks and returns the last
+// revision it has seen
+func watch(ctx context.Context, client *clientv3.Client, rev int64) int64 {
+	for res := range client.Watch(ctx, EtcdKey, clientv3.WithRev(rev+1)) {
+		if err := res.Err(); err != nil {
+			log.Printf("blueprint watch failed: %v", err)
+			return rev
+		}
+
+		for _, event := range res.Events {
+			if event.Type != clientv3.EventTypePut {
+				log.Printf("blueprint catalog was deleted from etcd, keeping version %d", Version())
+				continue
+			}
+
+			if err := apply(event.Kv.Value, event.Kv.ModRevision); err != nil {
+				log.Printf("ignoring blueprint catalog at revision %d: %v", event.Kv.ModRevision, err)
+			}
+		}
+		rev = res.Header.Revision
+	}
+
+	return rev
+}
+
+// apply swaps in a catalog read from etcd. Catalogs without an explicit
+// version are versioned by the etcd revision that last modified them.
+func apply(data []byte, revision int64) error {
+	loaded, err := Parse(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if loaded.version == 0 {
+		loaded.version = revision
+	}
+
+	if previous := blueprints.Swap(loaded); previous.version != loaded.version {
+		log.Printf("loaded blueprint catalog version %d", loaded.version)
+	}
+
+	return nil
+}
@@ -0,0 +1,409 @@
+package registry
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+//go:embed blueprints.json
+var defaultCatalog []byte
+
+// defaultSalvagePercent is used for blueprints that do not set salvage_percent
+const defaultSalvagePercent int64 = 50
+
+// catalog is the on-disk format of the resource and blueprint definitions
+type catalog struct {
+	Version    int64                    `json:"version"`
+	Resources  map[string]resourceSpec  `json:"resources"`
+	Blueprints map[string]blueprintSpec `json:"blueprints"`
+}
+
+type resourceSpec struct {
+	Name        string `json:"name"`
+	Category    string `json:"category"`
+	Tradeable   bool   `json:"tradeable"`
+	Starting    int64  `json:"starting_amount"`
+	Consumption int64  `json:"consumption"`
+}
+
+type blueprintSpec struct {
+	Name           string            `json:"name"`
+	Cost           map[string]int64  `json:"cost"`
+	Time           string            `json:"time"`
+	RefundPercent  *int64            `json:"refund_percent"`
+	SalvagePercent *int64            `json:"salvage_percent"`
+	Requirements   []requirementSpec `json:"requirements"`
+	Production     map[string]int64  `json:"production"`
+	Upkeep         map[string]int64  `json:"upkeep"`
+	Storage        map[string]int64  `json:"storage"`
+	Housing        int64             `json:"housing"`
+	Workers        int64             `json:"workers"`
+	Levels         []levelSpec       `json:"levels"`
+}
+
+// levelSpec defines a level above the first. Effects left out are the same
+// as on the level below.
+type levelSpec struct {
+	Cost       map[string]int64 `json:"cost"`
+	Time       string           `json:"time"`
+	Production map[string]int64 `json:"production"`
+	Upkeep     map[string]int64 `json:"upkeep"`
+	Storage    map[string]int64 `json:"storage"`
+	Housing    *int64           `json:"housing"`
+	Workers    *int64           `json:"workers"`
+}
+
+type requirementSpec struct {
+	Blueprint string `json:"blueprint"`
+	Count     *int64 `json:"count"`
+}
+
+// LoadFile replaces the blueprint catalog with the one defined in the JSON
+// file at path. The current catalog is kept if the file is invalid.
+func LoadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening blueprint catalog: %w", err)
+	}
+	defer file.Close()
+
+	loaded, err := Parse(file)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	blueprints.Store(loaded)
+	return nil
+}
+
+// Parse reads and validates a JSON blueprint catalog
+func Parse(r io.Reader) (*Blueprints, error) {
+	source, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading blueprint catalog: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(source))
+	decoder.DisallowUnknownFields()
+
+	var c catalog
+	if err := decoder.Decode(&c); err != nil {
+		return nil, fmt.Errorf("malformed blueprint catalog: %w", err)
+	}
+
+	if len(c.Blueprints) == 0 {
+		return nil, fmt.Errorf("blueprint catalog is empty")
+	}
+
+	if len(c.Resources) == 0 {
+		return nil, fmt.Errorf("resource catalog is empty")
+	}
+
+	resourceKeys := make([]string, 0, len(c.Resources))
+	for key := range c.Resources {
+		resourceKeys = append(resourceKeys, key)
+	}
+	sort.Strings(resourceKeys)
+
+	keys := make([]string, 0, len(c.Blueprints))
+	for key := range c.Blueprints {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	problems := make([]string, 0)
+	for _, key := range resourceKeys {
+		for _, problem := range validateResource(key, c.Resources[key]) {
+			problems = append(problems, fmt.Sprintf("resource %q: %s", key, problem))
+		}
+	}
+	for _, key := range keys {
+		for _, problem := range validate(key, c.Blueprints[key], c.Blueprints, c.Resources) {
+			problems = append(problems, fmt.Sprintf("blueprint %q: %s", key, problem))
+		}
+	}
+	if len(problems) == 0 {
+		if cycle := findCycle(keys, c.Blueprints); cycle != nil {
+			problems = append(problems, fmt.Sprintf("requirement cycle %s", strings.Join(cycle, " -> ")))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid blueprint catalog: %s", strings.Join(problems, "; "))
+	}
+
+	resources := make(map[string]Resource, len(c.Resources))
+	for key, spec := range c.Resources {
+		resources[key] = Resource{
+			ID:          key,
+			Name:        spec.Name,
+			Category:    spec.Category,
+			Tradeable:   spec.Tradeable,
+			Starting:    spec.Starting,
+			Consumption: spec.Consumption,
+		}
+	}
+
+	store := make(map[string]Blueprint, len(c.Blueprints))
+	for _, key := range keys {
+		store[key] = resolve(key, c.Blueprints)
+	}
+
+	return &Blueprints{version: c.Version, store: store, resources: resources, source: source}, nil
+}
+
+func validateResource(key string, spec resourceSpec) []string {
+	problems := make([]string, 0)
+
+	if strings.TrimSpace(key) == "" {
+		problems = append(problems, "key cannot be empty")
+	}
+	if strings.TrimSpace(spec.Name) == "" {
+		problems = append(problems, "name cannot be empty")
+	}
+	if spec.Starting < 0 {
+		problems = append(problems, "starting_amount cannot be negative")
+	}
+	if spec.Consumption < 0 {
+		problems = append(problems, "consumption cannot be negative")
+	}
+
+	return problems
+}
+
+// validateAmounts checks a per-resource field of a blueprint such as its cost
+func validateAmounts(field string, amounts map[string]int64, resources map[string]resourceSpec) []string {
+	problems := make([]string, 0)
+
+	for resource, amount := range amounts {
+		if _, ok := resources[resource]; !ok {
+			problems = append(problems, fmt.Sprintf("unknown resource %q in %s", resource, field))
+		}
+		if amount < 0 {
+			problems = append(problems, fmt.Sprintf("%s of %s cannot be negative", field, resource))
+		}
+	}
+
+	return problems
+}
+
+func validate(key string, spec blueprintSpec, all map[string]blueprintSpec, resources map[string]resourceSpec) []string {
+	problems := make([]string, 0)
+
+	if strings.TrimSpace(key) == "" {
+		problems = append(problems, "key cannot be empty")
+	}
+	if strings.TrimSpace(spec.Name) == "" {
+		problems = append(problems, "name cannot be empty")
+	}
+
+	problems = append(problems, validateAmounts("cost", spec.Cost, resources)...)
+	problems = append(problems, validateAmounts("production", spec.Production, resources)...)
+	problems = append(problems, validateAmounts("upkeep", spec.Upkeep, resources)...)
+	problems = append(problems, validateAmounts("storage", spec.Storage, resources)...)
+
+	if spec.Housing < 0 {
+		problems = append(problems, "housing cannot be negative")
+	}
+	if spec.Workers < 0 {
+		problems = append(problems, "workers cannot be negative")
+	}
+
+	problems = append(problems, validateTime(spec.Time)...)
+
+	for i, level := range spec.Levels {
+		levelProblems := make([]string, 0)
+		levelProblems = append(levelProblems, validateAmounts("cost", level.Cost, resources)...)
+		levelProblems = append(levelProblems, validateAmounts("production", level.Production, resources)...)
+		levelProblems = append(levelProblems, validateAmounts("upkeep", level.Upkeep, resources)...)
+		levelProblems = append(levelProblems, validateAmounts("storage", level.Storage, resources)...)
+		levelProblems = append(levelProblems, validateTime(level.Time)...)
+		if level.Housing != nil && *level.Housing < 0 {
+			levelProblems = append(levelProblems, "housing cannot be negative")
+		}
+		if level.Workers != nil && *level.Workers < 0 {
+			levelProblems = append(levelProblems, "workers cannot be negative")
+		}
+
+		for _, problem := range levelProblems {
+			problems = append(problems, fmt.Sprintf("level %d: %s", i+2, problem))
+		}
+	}
+
+	if spec.RefundPercent != nil && (*spec.RefundPercent < 0 || *spec.RefundPercent > 100) {
+		problems = append(problems, fmt.Sprintf("refund_percent must be between 0 and 100, got %d", *spec.RefundPercent))
+	}
+	if spec.SalvagePercent != nil && (*spec.SalvagePercent < 0 || *spec.SalvagePercent > 100) {
+		problems = append(problems, fmt.Sprintf("salvage_percent must be between 0 and 100, got %d", *spec.SalvagePercent))
+	}
+
+	for _, requirement := range spec.Requirements {
+		if _, ok := all[requirement.Blueprint]; !ok {
+			problems = append(problems, fmt.Sprintf("unknown requirement %q", requirement.Blueprint))
+		}
+		if requirement.Count != nil && *requirement.Count < 1 {
+			problems = append(problems, fmt.Sprintf("requirement %q must have a count of at least 1", requirement.Blueprint))
+		}
+	}
+
+	return problems
+}
+
+func validateTime(t string) []string {
+	if t == "" {
+		return nil
+	}
+	if d, err := time.ParseDuration(t); err != nil {
+		return []string{fmt.Sprintf("invalid time %q", t)}
+	} else if d < 0 {
+		return []string{fmt.Sprintf("time %q cannot be negative", t)}
+	}
+	return nil
+}
+
+// findCycle returns the first chain of requirements leading back to where it
+// started, or nil if the requirements form a tree
+func findCycle(keys []string, all map[string]blueprintSpec) []string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+
+	state := make(map[string]int, len(all))
+	path := make([]string, 0)
+
+	var visit func(key string) []string
+	visit = func(key string) []string {
+		switch state[key] {
+		case done:
+			return nil
+		case visiting:
+			for i, k := range path {
+				if k == key {
+					return append(append([]string{}, path[i:]...), key)
+				}
+			}
+		}
+
+		state[key] = visiting
+		path = append(path, key)
+		for _, requirement := range all[key].Requirements {
+			if cycle := visit(requirement.Blueprint); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[key] = done
+
+		return nil
+	}
+
+	for _, key := range keys {
+		if cycle := visit(key); cycle != nil {
+			return cycle
+		}
+	}
+
+	return nil
+}
+
+func resolve(key string, all map[string]blueprintSpec) Blueprint {
+	spec := all[key]
+
+	blueprint := Blueprint{
+		ID:             key,
+		Name:           spec.Name,
+		Cost:           spec.Cost,
+		Time:           spec.Time,
+		Production:     spec.Production,
+		Upkeep:         spec.Upkeep,
+		Storage:        spec.Storage,
+		Housing:        spec.Housing,
+		Workers:        spec.Workers,
+		RefundPercent:  100,
+		SalvagePercent: defaultSalvagePercent,
+		Requirements:   make([]Requirement, 0, len(spec.Requirements)),
+		Levels:         make([]Level, 0, len(spec.Levels)),
+	}
+	if blueprint.Cost == nil {
+		blueprint.Cost = make(map[string]int64)
+	}
+	if blueprint.Production == nil {
+		blueprint.Production = make(map[string]int64)
+	}
+	if blueprint.Upkeep == nil {
+		blueprint.Upkeep = make(map[string]int64)
+	}
+	if blueprint.Storage == nil {
+		blueprint.Storage = make(map[string]int64)
+	}
+	if spec.RefundPercent != nil {
+		blueprint.RefundPercent = *spec.RefundPercent
+	}
+	if spec.SalvagePercent != nil {
+		blueprint.SalvagePercent = *spec.SalvagePercent
+	}
+
+	for _, requirement := range spec.Requirements {
+		var count int64 = 1
+		if requirement.Count != nil {
+			count = *requirement.Count
+		}
+		blueprint.Requirements = append(blueprint.Requirements, Requirement{
+			Blueprint: requirement.Blueprint,
+			Count:     count,
+		})
+	}
+
+	previous, _ := blueprint.Level(1)
+	for i, spec := range spec.Levels {
+		level := Level{
+			Level:      int64(i + 2),
+			Cost:       spec.Cost,
+			Time:       spec.Time,
+			Production: spec.Production,
+			Upkeep:     spec.Upkeep,
+			Storage:    spec.Storage,
+			Housing:    previous.Housing,
+			Workers:    previous.Workers,
+		}
+		if level.Cost == nil {
+			level.Cost = make(map[string]int64)
+		}
+		if level.Production == nil {
+			level.Production = previous.Production
+		}
+		if level.Upkeep == nil {
+			level.Upkeep = previous.Upkeep
+		}
+		if level.Storage == nil {
+			level.Storage = previous.Storage
+		}
+		if spec.Housing != nil {
+			level.Housing = *spec.Housing
+		}
+		if spec.Workers != nil {
+			level.Workers = *spec.Workers
+		}
+
+		blueprint.Levels = append(blueprint.Levels, level)
+		previous = level
+	}
+
+	return blueprint
+}
+
+func loadDefault() *Blueprints {
+	loaded, err := Parse(bytes.NewReader(defaultCatalog))
+	if err != nil {
+		panic(fmt.Errorf("built-in blueprint catalog: %w", err))
+	}
+	return loaded
+}
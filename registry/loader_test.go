@@ -0,0 +1,278 @@
+package registry
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+// testResources is the resources section shared by the test catalogs
+const testResources = `"resources": {
+	"wood": {"name": "Wood", "category": "material", "starting_amount": 10},
+	"stone": {"name": "Stone", "category": "material"}
+}`
+
+// parseCatalog parses a catalog with the test resources and the given
+// blueprints section
+func parseCatalog(blueprints string) (*Blueprints, error) {
+	return Parse(strings.NewReader(`{"version": 1, ` + testResources + `, "blueprints": {` + blueprints + `}}`))
+}
+
+func TestParse(t *testing.T) {
+	loaded, err := parseCatalog(`
+		"hut": {"name": "Hut", "cost": {"wood": 5}, "time": "1m", "housing": 5},
+		"quarry": {
+			"name": "Quarry",
+			"cost": {"wood": 20},
+			"time": "10m",
+			"requirements": [{"blueprint": "hut", "count": 2}],
+			"production": {"stone": 10},
+			"levels": [{"cost": {"wood": 40}, "production": {"stone": 25}}]
+		}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if loaded.version != 1 {
+		t.Errorf("want version 1, got %d", loaded.version)
+	}
+	if wood := loaded.resources["wood"]; wood.Name != "Wood" || wood.Starting != 10 {
+		t.Errorf("want wood with a starting amount of 10, got %+v", wood)
+	}
+
+	quarry := loaded.store["quarry"]
+	if quarry.RefundPercent != 100 || quarry.SalvagePercent != defaultSalvagePercent {
+		t.Errorf("want the default refund and salvage, got %d and %d", quarry.RefundPercent, quarry.SalvagePercent)
+	}
+	if len(quarry.Requirements) != 1 || quarry.Requirements[0] != (Requirement{Blueprint: "hut", Count: 2}) {
+		t.Errorf("want a requirement of 2 huts, got %+v", quarry.Requirements)
+	}
+	if len(quarry.Levels) != 1 || quarry.Levels[0].Production["stone"] != 25 {
+		t.Errorf("want a second level producing 25 stone, got %+v", quarry.Levels)
+	}
+}
+
+func TestParseRejectsMalformedCatalogs(t *testing.T) {
+	tests := []struct {
+		name    string
+		catalog string
+		want    string
+	}{
+		{
+			name:    "not json",
+			catalog: `{"version": 1,`,
+			want:    "malformed blueprint catalog",
+		},
+		{
+			name:    "unknown field",
+			catalog: `{"version": 1, ` + testResources + `, "blueprints": {"hut": {"name": "Hut", "colour": "red"}}}`,
+			want:    `unknown field "colour"`,
+		},
+		{
+			name:    "no blueprints",
+			catalog: `{"version": 1, ` + testResources + `, "blueprints": {}}`,
+			want:    "blueprint catalog is empty",
+		},
+		{
+			name:    "no resources",
+			catalog: `{"version": 1, "resources": {}, "blueprints": {"hut": {"name": "Hut"}}}`,
+			want:    "resource catalog is empty",
+		},
+		{
+			name:    "resources left out",
+			catalog: `{"version": 1, "blueprints": {"hut": {"name": "Hut"}}}`,
+			want:    "resource catalog is empty",
+		},
+		{
+			name:    "negative starting amount",
+			catalog: `{"version": 1, "resources": {"wood": {"name": "Wood", "starting_amount": -1}}, "blueprints": {"hut": {"name": "Hut"}}}`,
+			want:    `resource "wood": starting_amount cannot be negative`,
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.catalog))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: want an error containing %q, got %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestParseRejectsInvalidBlueprints(t *testing.T) {
+	tests := []struct {
+		name       string
+		blueprints string
+		want       string
+	}{
+		{
+			name:       "no name",
+			blueprints: `"hut": {"name": " "}`,
+			want:       `blueprint "hut": name cannot be empty`,
+		},
+		{
+			name:       "unknown cost resource",
+			blueprints: `"hut": {"name": "Hut", "cost": {"gold": 5}}`,
+			want:       `blueprint "hut": unknown resource "gold" in cost`,
+		},
+		{
+			name:       "unknown production resource",
+			blueprints: `"hut": {"name": "Hut", "production": {"gold": 5}}`,
+			want:       `blueprint "hut": unknown resource "gold" in production`,
+		},
+		{
+			name:       "negative cost",
+			blueprints: `"hut": {"name": "Hut", "cost": {"wood": -5}}`,
+			want:       `blueprint "hut": cost of wood cannot be negative`,
+		},
+		{
+			name:       "negative upkeep",
+			blueprints: `"hut": {"name": "Hut", "upkeep": {"wood": -1}}`,
+			want:       `blueprint "hut": upkeep of wood cannot be negative`,
+		},
+		{
+			name:       "negative housing",
+			blueprints: `"hut": {"name": "Hut", "housing": -1}`,
+			want:       `blueprint "hut": housing cannot be negative`,
+		},
+		{
+			name:       "negative amount on a level",
+			blueprints: `"hut": {"name": "Hut", "levels": [{"storage": {"wood": -1}}]}`,
+			want:       `blueprint "hut": level 2: storage of wood cannot be negative`,
+		},
+		{
+			name:       "bad time",
+			blueprints: `"hut": {"name": "Hut", "time": "soon"}`,
+			want:       `blueprint "hut": invalid time "soon"`,
+		},
+		{
+			name:       "negative time",
+			blueprints: `"hut": {"name": "Hut", "time": "-1m"}`,
+			want:       `blueprint "hut": time "-1m" cannot be negative`,
+		},
+		{
+			name:       "refund above 100",
+			blueprints: `"hut": {"name": "Hut", "refund_percent": 101}`,
+			want:       `blueprint "hut": refund_percent must be between 0 and 100, got 101`,
+		},
+		{
+			name:       "refund below 0",
+			blueprints: `"hut": {"name": "Hut", "refund_percent": -1}`,
+			want:       `blueprint "hut": refund_percent must be between 0 and 100, got -1`,
+		},
+		{
+			name:       "salvage above 100",
+			blueprints: `"hut": {"name": "Hut", "salvage_percent": 150}`,
+			want:       `blueprint "hut": salvage_percent must be between 0 and 100, got 150`,
+		},
+		{
+			name:       "unknown requirement",
+			blueprints: `"hut": {"name": "Hut", "requirements": [{"blueprint": "castle"}]}`,
+			want:       `blueprint "hut": unknown requirement "castle"`,
+		},
+		{
+			name:       "requirement count below 1",
+			blueprints: `"hut": {"name": "Hut"}, "farm": {"name": "Farm", "requirements": [{"blueprint": "hut", "count": 0}]}`,
+			want:       `blueprint "farm": requirement "hut" must have a count of at least 1`,
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := parseCatalog(tt.blueprints)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: want an error containing %q, got %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestParseReportsEveryProblem(t *testing.T) {
+	_, err := parseCatalog(`
+		"hut": {"name": "Hut", "cost": {"gold": 5}},
+		"farm": {"name": "Farm", "time": "soon"}`)
+	if err == nil {
+		t.Fatal("want an error")
+	}
+
+	// problems are listed in order of the blueprint keys
+	want := `invalid blueprint catalog: blueprint "farm": invalid time "soon"; blueprint "hut": unknown resource "gold" in cost`
+	if err.Error() != want {
+		t.Errorf("want %q, got %q", want, err.Error())
+	}
+}
+
+func TestFindCycle(t *testing.T) {
+	requires := func(blueprints ...string) blueprintSpec {
+		spec := blueprintSpec{Name: "Building"}
+		for _, blueprint := range blueprints {
+			spec.Requirements = append(spec.Requirements, requirementSpec{Blueprint: blueprint})
+		}
+		return spec
+	}
+
+	tests := []struct {
+		name string
+		all  map[string]blueprintSpec
+		want []string
+	}{
+		{
+			name: "no requirements",
+			all:  map[string]blueprintSpec{"a": requires(), "b": requires()},
+		},
+		{
+			name: "chain",
+			all:  map[string]blueprintSpec{"a": requires("b"), "b": requires("c"), "c": requires()},
+		},
+		{
+			name: "diamond",
+			all: map[string]blueprintSpec{
+				"a": requires("b", "c"),
+				"b": requires("d"),
+				"c": requires("d"),
+				"d": requires(),
+			},
+		},
+		{
+			name: "self",
+			all:  map[string]blueprintSpec{"a": requires("a")},
+			want: []string{"a", "a"},
+		},
+		{
+			name: "two buildings",
+			all:  map[string]blueprintSpec{"a": requires("b"), "b": requires("a")},
+			want: []string{"a", "b", "a"},
+		},
+		{
+			name: "longer cycle",
+			all: map[string]blueprintSpec{
+				"a": requires("b"),
+				"b": requires("c"),
+				"c": requires("d"),
+				"d": requires("b"),
+			},
+			want: []string{"b", "c", "d", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		keys := make([]string, 0, len(tt.all))
+		for key := range tt.all {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		got := findCycle(keys, tt.all)
+		if strings.Join(got, " -> ") != strings.Join(tt.want, " -> ") {
+			t.Errorf("%s: want cycle %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestParseRejectsRequirementCycles(t *testing.T) {
+	_, err := parseCatalog(`
+		"hut": {"name": "Hut", "requirements": [{"blueprint": "farm"}]},
+		"farm": {"name": "Farm", "requirements": [{"blueprint": "hut"}]}`)
+
+	want := "invalid blueprint catalog: requirement cycle farm -> hut -> farm"
+	if err == nil || err.Error() != want {
+		t.Errorf("want %q, got %v", want, err)
+	}
+}
@@ -1,49 +1,155 @@
 package registry
 
 import (
-	"fmt"
-
-	"github.com/alfreddobradi/actor-game/shared"
+	"errors"
+	"sort"
+	"sync/atomic"
+	"time"
 )
 
-var blueprints *Blueprints
+var ErrBlueprintNotFound = errors.New("blueprint not found")
+
+// blueprints holds the active catalog. It is swapped as a whole on reload so
+// readers always see a consistent set of blueprints.
+var blueprints atomic.Pointer[Blueprints]
 
+// Blueprint describes a building. ID is the key the blueprint is stored
+// under in the catalog and is what requests, requirements and inventories
+// refer to; Name is for display only.
 type Blueprint struct {
-	Name         string
-	Requirements []Blueprint
-	Cost         map[string]int64
-	Time         string
+	ID           string           `json:"id"`
+	Name         string           `json:"name"`
+	Requirements []Requirement    `json:"requirements"`
+	Cost         map[string]int64 `json:"cost"`
+	Time         string           `json:"time"`
+	// RefundPercent is the share of Cost returned when a construction is
+	// cancelled after it started. Queued constructions are always refunded
+	// in full.
+	RefundPercent int64 `json:"refund_percent"`
+	// SalvagePercent is the share of everything spent on building and
+	// upgrading a building returned when it is demolished
+	SalvagePercent int64 `json:"salvage_percent"`
+	// Production is the amount of each resource a finished building of this
+	// blueprint yields per hour
+	Production map[string]int64 `json:"production"`
+	// Upkeep is the amount of each resource a finished building of this
+	// blueprint consumes per hour. Buildings that owe upkeep they cannot pay
+	// are inactive: they produce, store, house and employ nothing until it
+	// is paid off.
+	Upkeep map[string]int64 `json:"upkeep"`
+	// Storage is the amount of each resource a finished building of this
+	// blueprint adds to the player's storage capacity
+	Storage map[string]int64 `json:"storage"`
+	// Housing is the number of people a finished building of this blueprint
+	// houses
+	Housing int64 `json:"housing"`
+	// Workers is the number of workers a finished building of this blueprint
+	// needs to reach its full Production. Buildings without workers produce
+	// on their own.
+	Workers int64 `json:"workers"`
+	// Levels are the levels buildings of this blueprint can be upgraded to,
+	// starting at level 2. The blueprint itself describes level 1.
+	Levels []Level `json:"levels"`
+}
+
+// Level describes the buildings of a blueprint at one level. Cost and Time
+// are what it takes to upgrade a building to the level, the other fields
+// mean the same as on Blueprint.
+type Level struct {
+	Level      int64            `json:"level"`
+	Cost       map[string]int64 `json:"cost"`
+	Time       string           `json:"time"`
+	Production map[string]int64 `json:"production"`
+	Upkeep     map[string]int64 `json:"upkeep"`
+	Storage    map[string]int64 `json:"storage"`
+	Housing    int64            `json:"housing"`
+	Workers    int64            `json:"workers"`
+}
+
+// Requirement is a minimum number of finished buildings of another blueprint
+// a player needs before being allowed to build
+type Requirement struct {
+	Blueprint string `json:"blueprint"`
+	Count     int64  `json:"count"`
 }
 
 type Blueprints struct {
-	store map[string]Blueprint
+	version   int64
+	store     map[string]Blueprint
+	resources map[string]Resource
+	// source is the catalog document the blueprints were parsed from
+	source []byte
+}
+
+// Duration parses the build time of the blueprint
+func (b Blueprint) Duration() (time.Duration, error) {
+	if b.Time == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(b.Time)
+}
+
+// Duration parses the upgrade time of the level
+func (l Level) Duration() (time.Duration, error) {
+	if l.Time == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(l.Time)
 }
 
-func GetBlueprint(name string) (Blueprint, error) {
-	if blueprint, ok := blueprints.store[name]; ok {
+// Level returns the given level of the blueprint, reporting false if the
+// blueprint has no such level
+func (b Blueprint) Level(level int64) (Level, bool) {
+	if level == 1 {
+		return Level{
+			Level:      1,
+			Cost:       b.Cost,
+			Time:       b.Time,
+			Production: b.Production,
+			Upkeep:     b.Upkeep,
+			Storage:    b.Storage,
+			Housing:    b.Housing,
+			Workers:    b.Workers,
+		}, true
+	}
+	if level < 1 || level > int64(len(b.Levels))+1 {
+		return Level{}, false
+	}
+	return b.Levels[level-2], true
+}
+
+// Lookup returns the blueprint with the given ID from the active catalog
+func Lookup(id string) (Blueprint, error) {
+	if blueprint, ok := blueprints.Load().store[id]; ok {
 		return blueprint, nil
 	}
-	return Blueprint{}, fmt.Errorf("blueprint not found")
+	return Blueprint{}, ErrBlueprintNotFound
 }
 
-func init() {
-	loadBlueprints()
-}
-
-func loadBlueprints() {
-	// TODO make a loader function here (from file, remote, etc)
-	if blueprints == nil {
-		blueprints = &Blueprints{
-			store: map[string]Blueprint{
-				BlueprintHouse: {
-					Name:         "House",
-					Requirements: nil,
-					Cost: map[string]int64{
-						shared.ResourceWood: 30,
-					},
-					Time: "1h",
-				},
-			},
-		}
+// List returns every blueprint of the active catalog ordered by ID
+func List() []Blueprint {
+	store := blueprints.Load().store
+	list := make([]Blueprint, 0, len(store))
+	for _, blueprint := range store {
+		list = append(list, blueprint)
 	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+	return list
+}
+
+// Validate reports whether id refers to a blueprint of the active catalog
+func Validate(id string) error {
+	_, err := Lookup(id)
+	return err
+}
+
+// Version returns the version of the active blueprint catalog
+func Version() int64 {
+	return blueprints.Load().version
+}
+
+func init() {
+	blueprints.Store(loadDefault())
 }
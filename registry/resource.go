@@ -0,0 +1,45 @@
+package registry
+
+import (
+	"errors"
+	"sort"
+)
+
+var ErrResourceNotFound = errors.New("resource not found")
+
+// Resource describes something players can own. ID is what costs, production
+// and inventories refer to; Name is for display only.
+type Resource struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	// Tradeable reports whether players may exchange the resource
+	Tradeable bool `json:"tradeable"`
+	// Starting is the amount every new inventory is seeded with
+	Starting int64 `json:"starting_amount"`
+	// Consumption is the amount each person in an inventory consumes per
+	// hour. People leave while there is not enough to feed them.
+	Consumption int64 `json:"consumption"`
+}
+
+// LookupResource returns the resource with the given ID from the active
+// catalog
+func LookupResource(id string) (Resource, error) {
+	if resource, ok := blueprints.Load().resources[id]; ok {
+		return resource, nil
+	}
+	return Resource{}, ErrResourceNotFound
+}
+
+// Resources returns every resource of the active catalog ordered by ID
+func Resources() []Resource {
+	store := blueprints.Load().resources
+	list := make([]Resource, 0, len(store))
+	for _, resource := range store {
+		list = append(list, resource)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+	return list
+}
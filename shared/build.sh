@@ -1,4 +1,4 @@
-protoc --go_out=. --go_opt=paths=source_relative --proto_path=. common.proto
-protoc -I=. -I=$GOPATH/src --gograinv2_out=. common.proto
+protoc --go_out=. --go_opt=paths=source_relative --proto_path=. v1/common.proto
+protoc -I=. -I=$GOPATH/src --gograinv2_out=. v1/common.proto
 
-goimports -w .
\ No newline at end of file
+goimports -w .
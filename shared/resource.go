@@ -1,5 +0,0 @@
-package shared
-
-const (
-	ResourceWood string = "wood"
-)
@@ -1,5 +1,5 @@
-// Package shared is generated by protoactor-go/protoc-gen-gograin@0.1.0
-package shared
+// Package v1 is generated by protoactor-go/protoc-gen-gograin@0.1.0
+package v1
 
 import (
 	"errors"
@@ -14,7 +14,7 @@ import (
 )
 
 var (
-	plog = logmod.New(logmod.InfoLevel, "[GRAIN][shared]")
+	plog = logmod.New(logmod.InfoLevel, "[GRAIN][v1]")
 	_    = proto.Marshal
 	_    = fmt.Errorf
 	_    = math.Inf
@@ -353,6 +353,11 @@ type Inventory interface {
 	ReceiveDefault(ctx cluster.GrainContext)
 	Describe(*DescribeInventoryRequest, cluster.GrainContext) (*DescribeInventoryResponse, error)
 	StartBuild(*BuildRequest, cluster.GrainContext) (*BuildResponse, error)
+	CancelBuild(*CancelBuildRequest, cluster.GrainContext) (*CancelBuildResponse, error)
+	ListBlueprints(*ListBlueprintsRequest, cluster.GrainContext) (*ListBlueprintsResponse, error)
+	AssignWorkers(*AssignWorkersRequest, cluster.GrainContext) (*AssignWorkersResponse, error)
+	UpgradeBuilding(*UpgradeBuildingRequest, cluster.GrainContext) (*BuildResponse, error)
+	Demolish(*DemolishRequest, cluster.GrainContext) (*DemolishResponse, error)
 }
 
 // InventoryGrainClient holds the base data for the InventoryGrain
@@ -413,6 +418,136 @@ func (g *InventoryGrainClient) StartBuild(r *BuildRequest, opts ...cluster.Grain
 	}
 }
 
+// CancelBuild requests the execution on to the cluster with CallOptions
+func (g *InventoryGrainClient) CancelBuild(r *CancelBuildRequest, opts ...cluster.GrainCallOption) (*CancelBuildResponse, error) {
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	reqMsg := &cluster.GrainRequest{MethodIndex: 2, MessageData: bytes}
+	resp, err := g.cluster.Call(g.Identity, "Inventory", reqMsg, opts...)
+	if err != nil {
+		return nil, err
+	}
+	switch msg := resp.(type) {
+	case *cluster.GrainResponse:
+		result := &CancelBuildResponse{}
+		err = proto.Unmarshal(msg.MessageData, result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	case *cluster.GrainErrorResponse:
+		return nil, errors.New(msg.Err)
+	default:
+		return nil, errors.New("unknown response")
+	}
+}
+
+// ListBlueprints requests the execution on to the cluster with CallOptions
+func (g *InventoryGrainClient) ListBlueprints(r *ListBlueprintsRequest, opts ...cluster.GrainCallOption) (*ListBlueprintsResponse, error) {
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	reqMsg := &cluster.GrainRequest{MethodIndex: 3, MessageData: bytes}
+	resp, err := g.cluster.Call(g.Identity, "Inventory", reqMsg, opts...)
+	if err != nil {
+		return nil, err
+	}
+	switch msg := resp.(type) {
+	case *cluster.GrainResponse:
+		result := &ListBlueprintsResponse{}
+		err = proto.Unmarshal(msg.MessageData, result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	case *cluster.GrainErrorResponse:
+		return nil, errors.New(msg.Err)
+	default:
+		return nil, errors.New("unknown response")
+	}
+}
+
+// AssignWorkers requests the execution on to the cluster with CallOptions
+func (g *InventoryGrainClient) AssignWorkers(r *AssignWorkersRequest, opts ...cluster.GrainCallOption) (*AssignWorkersResponse, error) {
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	reqMsg := &cluster.GrainRequest{MethodIndex: 4, MessageData: bytes}
+	resp, err := g.cluster.Call(g.Identity, "Inventory", reqMsg, opts...)
+	if err != nil {
+		return nil, err
+	}
+	switch msg := resp.(type) {
+	case *cluster.GrainResponse:
+		result := &AssignWorkersResponse{}
+		err = proto.Unmarshal(msg.MessageData, result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	case *cluster.GrainErrorResponse:
+		return nil, errors.New(msg.Err)
+	default:
+		return nil, errors.New("unknown response")
+	}
+}
+
+// UpgradeBuilding requests the execution on to the cluster with CallOptions
+func (g *InventoryGrainClient) UpgradeBuilding(r *UpgradeBuildingRequest, opts ...cluster.GrainCallOption) (*BuildResponse, error) {
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	reqMsg := &cluster.GrainRequest{MethodIndex: 5, MessageData: bytes}
+	resp, err := g.cluster.Call(g.Identity, "Inventory", reqMsg, opts...)
+	if err != nil {
+		return nil, err
+	}
+	switch msg := resp.(type) {
+	case *cluster.GrainResponse:
+		result := &BuildResponse{}
+		err = proto.Unmarshal(msg.MessageData, result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	case *cluster.GrainErrorResponse:
+		return nil, errors.New(msg.Err)
+	default:
+		return nil, errors.New("unknown response")
+	}
+}
+
+// Demolish requests the execution on to the cluster with CallOptions
+func (g *InventoryGrainClient) Demolish(r *DemolishRequest, opts ...cluster.GrainCallOption) (*DemolishResponse, error) {
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	reqMsg := &cluster.GrainRequest{MethodIndex: 6, MessageData: bytes}
+	resp, err := g.cluster.Call(g.Identity, "Inventory", reqMsg, opts...)
+	if err != nil {
+		return nil, err
+	}
+	switch msg := resp.(type) {
+	case *cluster.GrainResponse:
+		result := &DemolishResponse{}
+		err = proto.Unmarshal(msg.MessageData, result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	case *cluster.GrainErrorResponse:
+		return nil, errors.New(msg.Err)
+	default:
+		return nil, errors.New("unknown response")
+	}
+}
+
 // InventoryActor represents the actor structure
 type InventoryActor struct {
 	ctx     cluster.GrainContext
@@ -489,6 +624,126 @@ func (a *InventoryActor) Receive(ctx actor.Context) {
 			}
 			resp := &cluster.GrainResponse{MessageData: bytes}
 			ctx.Respond(resp)
+		case 2:
+			req := &CancelBuildRequest{}
+			err := proto.Unmarshal(msg.MessageData, req)
+			if err != nil {
+				plog.Error("CancelBuild(CancelBuildRequest) proto.Unmarshal failed.", logmod.Error(err))
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			r0, err := a.inner.CancelBuild(req, a.ctx)
+			if err != nil {
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			bytes, err := proto.Marshal(r0)
+			if err != nil {
+				plog.Error("CancelBuild(CancelBuildRequest) proto.Marshal failed", logmod.Error(err))
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			resp := &cluster.GrainResponse{MessageData: bytes}
+			ctx.Respond(resp)
+		case 3:
+			req := &ListBlueprintsRequest{}
+			err := proto.Unmarshal(msg.MessageData, req)
+			if err != nil {
+				plog.Error("ListBlueprints(ListBlueprintsRequest) proto.Unmarshal failed.", logmod.Error(err))
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			r0, err := a.inner.ListBlueprints(req, a.ctx)
+			if err != nil {
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			bytes, err := proto.Marshal(r0)
+			if err != nil {
+				plog.Error("ListBlueprints(ListBlueprintsRequest) proto.Marshal failed", logmod.Error(err))
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			resp := &cluster.GrainResponse{MessageData: bytes}
+			ctx.Respond(resp)
+		case 4:
+			req := &AssignWorkersRequest{}
+			err := proto.Unmarshal(msg.MessageData, req)
+			if err != nil {
+				plog.Error("AssignWorkers(AssignWorkersRequest) proto.Unmarshal failed.", logmod.Error(err))
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			r0, err := a.inner.AssignWorkers(req, a.ctx)
+			if err != nil {
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			bytes, err := proto.Marshal(r0)
+			if err != nil {
+				plog.Error("AssignWorkers(AssignWorkersRequest) proto.Marshal failed", logmod.Error(err))
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			resp := &cluster.GrainResponse{MessageData: bytes}
+			ctx.Respond(resp)
+		case 5:
+			req := &UpgradeBuildingRequest{}
+			err := proto.Unmarshal(msg.MessageData, req)
+			if err != nil {
+				plog.Error("UpgradeBuilding(UpgradeBuildingRequest) proto.Unmarshal failed.", logmod.Error(err))
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			r0, err := a.inner.UpgradeBuilding(req, a.ctx)
+			if err != nil {
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			bytes, err := proto.Marshal(r0)
+			if err != nil {
+				plog.Error("UpgradeBuilding(UpgradeBuildingRequest) proto.Marshal failed", logmod.Error(err))
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			resp := &cluster.GrainResponse{MessageData: bytes}
+			ctx.Respond(resp)
+		case 6:
+			req := &DemolishRequest{}
+			err := proto.Unmarshal(msg.MessageData, req)
+			if err != nil {
+				plog.Error("Demolish(DemolishRequest) proto.Unmarshal failed.", logmod.Error(err))
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			r0, err := a.inner.Demolish(req, a.ctx)
+			if err != nil {
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			bytes, err := proto.Marshal(r0)
+			if err != nil {
+				plog.Error("Demolish(DemolishRequest) proto.Marshal failed", logmod.Error(err))
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			resp := &cluster.GrainResponse{MessageData: bytes}
+			ctx.Respond(resp)
 
 		}
 	default:
@@ -635,3 +890,194 @@ func (a *TimerActor) Receive(ctx actor.Context) {
 		a.inner.ReceiveDefault(a.ctx)
 	}
 }
+
+var xPlayerFactory func() Player
+
+// PlayerFactory produces a Player
+func PlayerFactory(factory func() Player) {
+	xPlayerFactory = factory
+}
+
+// GetPlayerGrainClient instantiates a new PlayerGrainClient with given Identity
+func GetPlayerGrainClient(c *cluster.Cluster, id string) *PlayerGrainClient {
+	if c == nil {
+		panic(fmt.Errorf("nil cluster instance"))
+	}
+	if id == "" {
+		panic(fmt.Errorf("empty id"))
+	}
+	return &PlayerGrainClient{Identity: id, cluster: c}
+}
+
+// GetPlayerKind instantiates a new cluster.Kind for Player
+func GetPlayerKind(opts ...actor.PropsOption) *cluster.Kind {
+	props := actor.PropsFromProducer(func() actor.Actor {
+		return &PlayerActor{
+			Timeout: 60 * time.Second,
+		}
+	}, opts...)
+	kind := cluster.NewKind("Player", props)
+	return kind
+}
+
+// GetPlayerKind instantiates a new cluster.Kind for Player
+func NewPlayerKind(factory func() Player, timeout time.Duration, opts ...actor.PropsOption) *cluster.Kind {
+	xPlayerFactory = factory
+	props := actor.PropsFromProducer(func() actor.Actor {
+		return &PlayerActor{
+			Timeout: timeout,
+		}
+	}, opts...)
+	kind := cluster.NewKind("Player", props)
+	return kind
+}
+
+// Player interfaces the services available to the Player
+type Player interface {
+	Init(ctx cluster.GrainContext)
+	Terminate(ctx cluster.GrainContext)
+	ReceiveDefault(ctx cluster.GrainContext)
+	Register(*RegisterPlayerRequest, cluster.GrainContext) (*PlayerResponse, error)
+	Login(*LoginRequest, cluster.GrainContext) (*PlayerResponse, error)
+}
+
+// PlayerGrainClient holds the base data for the PlayerGrain
+type PlayerGrainClient struct {
+	Identity string
+	cluster  *cluster.Cluster
+}
+
+// Register requests the execution on to the cluster with CallOptions
+func (g *PlayerGrainClient) Register(r *RegisterPlayerRequest, opts ...cluster.GrainCallOption) (*PlayerResponse, error) {
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	reqMsg := &cluster.GrainRequest{MethodIndex: 0, MessageData: bytes}
+	resp, err := g.cluster.Call(g.Identity, "Player", reqMsg, opts...)
+	if err != nil {
+		return nil, err
+	}
+	switch msg := resp.(type) {
+	case *cluster.GrainResponse:
+		result := &PlayerResponse{}
+		err = proto.Unmarshal(msg.MessageData, result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	case *cluster.GrainErrorResponse:
+		return nil, errors.New(msg.Err)
+	default:
+		return nil, errors.New("unknown response")
+	}
+}
+
+// Login requests the execution on to the cluster with CallOptions
+func (g *PlayerGrainClient) Login(r *LoginRequest, opts ...cluster.GrainCallOption) (*PlayerResponse, error) {
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	reqMsg := &cluster.GrainRequest{MethodIndex: 1, MessageData: bytes}
+	resp, err := g.cluster.Call(g.Identity, "Player", reqMsg, opts...)
+	if err != nil {
+		return nil, err
+	}
+	switch msg := resp.(type) {
+	case *cluster.GrainResponse:
+		result := &PlayerResponse{}
+		err = proto.Unmarshal(msg.MessageData, result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	case *cluster.GrainErrorResponse:
+		return nil, errors.New(msg.Err)
+	default:
+		return nil, errors.New("unknown response")
+	}
+}
+
+// PlayerActor represents the actor structure
+type PlayerActor struct {
+	ctx     cluster.GrainContext
+	inner   Player
+	Timeout time.Duration
+}
+
+// Receive ensures the lifecycle of the actor for the received message
+func (a *PlayerActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *actor.Started: //pass
+	case *cluster.ClusterInit:
+		a.ctx = cluster.NewGrainContext(ctx, msg.Identity, msg.Cluster)
+		a.inner = xPlayerFactory()
+		a.inner.Init(a.ctx)
+
+		if a.Timeout > 0 {
+			ctx.SetReceiveTimeout(a.Timeout)
+		}
+	case *actor.ReceiveTimeout:
+		ctx.Poison(ctx.Self())
+	case *actor.Stopped:
+		a.inner.Terminate(a.ctx)
+	case actor.AutoReceiveMessage: // pass
+	case actor.SystemMessage: // pass
+
+	case *cluster.GrainRequest:
+		switch msg.MethodIndex {
+		case 0:
+			req := &RegisterPlayerRequest{}
+			err := proto.Unmarshal(msg.MessageData, req)
+			if err != nil {
+				plog.Error("Register(RegisterPlayerRequest) proto.Unmarshal failed.", logmod.Error(err))
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			r0, err := a.inner.Register(req, a.ctx)
+			if err != nil {
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			bytes, err := proto.Marshal(r0)
+			if err != nil {
+				plog.Error("Register(RegisterPlayerRequest) proto.Marshal failed", logmod.Error(err))
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			resp := &cluster.GrainResponse{MessageData: bytes}
+			ctx.Respond(resp)
+		case 1:
+			req := &LoginRequest{}
+			err := proto.Unmarshal(msg.MessageData, req)
+			if err != nil {
+				plog.Error("Login(LoginRequest) proto.Unmarshal failed.", logmod.Error(err))
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			r0, err := a.inner.Login(req, a.ctx)
+			if err != nil {
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			bytes, err := proto.Marshal(r0)
+			if err != nil {
+				plog.Error("Login(LoginRequest) proto.Marshal failed", logmod.Error(err))
+				resp := &cluster.GrainErrorResponse{Err: err.Error()}
+				ctx.Respond(resp)
+				return
+			}
+			resp := &cluster.GrainResponse{MessageData: bytes}
+			ctx.Respond(resp)
+
+		}
+	default:
+		a.inner.ReceiveDefault(a.ctx)
+	}
+}
@@ -0,0 +1,486 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// baselinePath is the descriptor of the wire messages as last released. Run
+// the test with -update after a compatible change to accept it.
+var baselinePath = filepath.Join("testdata", "common.json")
+
+var update = flag.Bool("update", false, "overwrite the checked-in descriptor with the current one")
+
+// wireGroups lists the field types that can be swapped for one another
+// without changing how existing data is decoded
+var wireGroups = map[descriptorpb.FieldDescriptorProto_Type]string{
+	descriptorpb.FieldDescriptorProto_TYPE_INT32:    "varint",
+	descriptorpb.FieldDescriptorProto_TYPE_INT64:    "varint",
+	descriptorpb.FieldDescriptorProto_TYPE_UINT32:   "varint",
+	descriptorpb.FieldDescriptorProto_TYPE_UINT64:   "varint",
+	descriptorpb.FieldDescriptorProto_TYPE_BOOL:     "varint",
+	descriptorpb.FieldDescriptorProto_TYPE_SINT32:   "zigzag",
+	descriptorpb.FieldDescriptorProto_TYPE_SINT64:   "zigzag",
+	descriptorpb.FieldDescriptorProto_TYPE_FIXED32:  "fixed32",
+	descriptorpb.FieldDescriptorProto_TYPE_SFIXED32: "fixed32",
+	descriptorpb.FieldDescriptorProto_TYPE_FIXED64:  "fixed64",
+	descriptorpb.FieldDescriptorProto_TYPE_SFIXED64: "fixed64",
+	descriptorpb.FieldDescriptorProto_TYPE_STRING:   "bytes",
+	descriptorpb.FieldDescriptorProto_TYPE_BYTES:    "bytes",
+}
+
+func TestWireCompatibility(t *testing.T) {
+	current := protodesc.ToFileDescriptorProto(File_v1_common_proto)
+
+	if *update {
+		data, err := marshalDescriptor(current)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(baselinePath, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	for _, problem := range compareFile(loadBaseline(t), current) {
+		t.Error(problem)
+	}
+}
+
+func loadBaseline(t *testing.T) *descriptorpb.FileDescriptorProto {
+	t.Helper()
+
+	data, err := os.ReadFile(baselinePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	baseline := &descriptorpb.FileDescriptorProto{}
+	if err := protojson.Unmarshal(data, baseline); err != nil {
+		t.Fatalf("invalid descriptor in %s: %v", baselinePath, err)
+	}
+	return baseline
+}
+
+// compareFile reports everything in the old descriptor that the current one
+// no longer handles the same way
+func compareFile(old, current *descriptorpb.FileDescriptorProto) []string {
+	problems := make([]string, 0)
+
+	if old.GetPackage() != current.GetPackage() {
+		problems = append(problems, fmt.Sprintf("package changed from %s to %s", old.GetPackage(), current.GetPackage()))
+	}
+
+	messages := make(map[string]*descriptorpb.DescriptorProto)
+	for _, m := range current.GetMessageType() {
+		messages[m.GetName()] = m
+	}
+	for _, o := range old.GetMessageType() {
+		m, ok := messages[o.GetName()]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("message %s was removed", o.GetName()))
+			continue
+		}
+		problems = append(problems, compareMessage(o, m)...)
+	}
+
+	enums := make(map[string]*descriptorpb.EnumDescriptorProto)
+	for _, e := range current.GetEnumType() {
+		enums[e.GetName()] = e
+	}
+	for _, o := range old.GetEnumType() {
+		e, ok := enums[o.GetName()]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("enum %s was removed", o.GetName()))
+			continue
+		}
+		problems = append(problems, compareEnum(o, e)...)
+	}
+
+	services := make(map[string]*descriptorpb.ServiceDescriptorProto)
+	for _, service := range current.GetService() {
+		services[service.GetName()] = service
+	}
+	for _, o := range old.GetService() {
+		service, ok := services[o.GetName()]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("service %s was removed", o.GetName()))
+			continue
+		}
+		problems = append(problems, compareService(o, service)...)
+	}
+
+	return problems
+}
+
+// compareMessage reports fields of the old message that are decoded
+// differently by the current one. Fields may be renamed, but removed fields
+// have to be reserved so their numbers are never reused, and reserved numbers
+// stay reserved.
+func compareMessage(old, current *descriptorpb.DescriptorProto) []string {
+	problems := make([]string, 0)
+
+	fields := make(map[int32]*descriptorpb.FieldDescriptorProto)
+	for _, f := range current.GetField() {
+		fields[f.GetNumber()] = f
+	}
+
+	for _, o := range old.GetField() {
+		f, ok := fields[o.GetNumber()]
+		if !ok {
+			if !reservedFields(current, o.GetNumber(), o.GetNumber()+1) {
+				problems = append(problems, fmt.Sprintf("%s.%s (%d) was removed without reserving its number", old.GetName(), o.GetName(), o.GetNumber()))
+			}
+			continue
+		}
+
+		if o.GetLabel() != f.GetLabel() {
+			problems = append(problems, fmt.Sprintf("%s.%s (%d) changed from %s to %s", old.GetName(), o.GetName(), o.GetNumber(), o.GetLabel(), f.GetLabel()))
+		}
+		if !compatibleTypes(o, f) {
+			problems = append(problems, fmt.Sprintf("%s.%s (%d) changed type from %s to %s", old.GetName(), o.GetName(), o.GetNumber(), typeName(o), typeName(f)))
+		}
+	}
+
+	for _, r := range old.GetReservedRange() {
+		if !reservedFields(current, r.GetStart(), r.GetEnd()) {
+			problems = append(problems, fmt.Sprintf("%s no longer reserves %d to %d", old.GetName(), r.GetStart(), r.GetEnd()-1))
+		}
+	}
+
+	return problems
+}
+
+func compareEnum(old, current *descriptorpb.EnumDescriptorProto) []string {
+	problems := make([]string, 0)
+
+	values := make(map[int32]bool)
+	for _, v := range current.GetValue() {
+		values[v.GetNumber()] = true
+	}
+
+	for _, o := range old.GetValue() {
+		if values[o.GetNumber()] {
+			continue
+		}
+		if !reservedValues(current, o.GetNumber(), o.GetNumber()) {
+			problems = append(problems, fmt.Sprintf("%s.%s (%d) was removed without reserving its number", old.GetName(), o.GetName(), o.GetNumber()))
+		}
+	}
+
+	for _, r := range old.GetReservedRange() {
+		if !reservedValues(current, r.GetStart(), r.GetEnd()) {
+			problems = append(problems, fmt.Sprintf("%s no longer reserves %d to %d", old.GetName(), r.GetStart(), r.GetEnd()))
+		}
+	}
+
+	return problems
+}
+
+// compareService reports methods of the old service that moved or changed
+// their messages. Grains are called by the index of the method in their
+// service, so new methods can only be added at the end.
+func compareService(old, current *descriptorpb.ServiceDescriptorProto) []string {
+	problems := make([]string, 0)
+
+	methods := current.GetMethod()
+	for i, o := range old.GetMethod() {
+		if i >= len(methods) {
+			problems = append(problems, fmt.Sprintf("%s.%s (%d) was removed", old.GetName(), o.GetName(), i))
+			continue
+		}
+
+		m := methods[i]
+		if o.GetName() != m.GetName() {
+			problems = append(problems, fmt.Sprintf("%s.%s (%d) was replaced by %s", old.GetName(), o.GetName(), i, m.GetName()))
+			continue
+		}
+		if o.GetInputType() != m.GetInputType() {
+			problems = append(problems, fmt.Sprintf("%s.%s (%d) changed its request from %s to %s", old.GetName(), o.GetName(), i, o.GetInputType(), m.GetInputType()))
+		}
+		if o.GetOutputType() != m.GetOutputType() {
+			problems = append(problems, fmt.Sprintf("%s.%s (%d) changed its response from %s to %s", old.GetName(), o.GetName(), i, o.GetOutputType(), m.GetOutputType()))
+		}
+	}
+
+	return problems
+}
+
+func compatibleTypes(old, current *descriptorpb.FieldDescriptorProto) bool {
+	if old.GetType() == current.GetType() {
+		return old.GetTypeName() == current.GetTypeName()
+	}
+
+	group, ok := wireGroups[old.GetType()]
+	return ok && group == wireGroups[current.GetType()]
+}
+
+func typeName(field *descriptorpb.FieldDescriptorProto) string {
+	if field.GetTypeName() != "" {
+		return field.GetTypeName()
+	}
+	return field.GetType().String()
+}
+
+// reservedFields reports whether the numbers from start up to but excluding
+// end are reserved in the message, possibly by more than one range
+func reservedFields(message *descriptorpb.DescriptorProto, start, end int32) bool {
+	ranges := make([][2]int64, 0, len(message.GetReservedRange()))
+	for _, r := range message.GetReservedRange() {
+		ranges = append(ranges, [2]int64{int64(r.GetStart()), int64(r.GetEnd())})
+	}
+	return covered(ranges, int64(start), int64(end))
+}
+
+// reservedValues reports whether the numbers from start up to and including
+// end are reserved in the enum, possibly by more than one range
+func reservedValues(enum *descriptorpb.EnumDescriptorProto, start, end int32) bool {
+	ranges := make([][2]int64, 0, len(enum.GetReservedRange()))
+	for _, r := range enum.GetReservedRange() {
+		// enum ranges include their end
+		ranges = append(ranges, [2]int64{int64(r.GetStart()), int64(r.GetEnd()) + 1})
+	}
+	return covered(ranges, int64(start), int64(end)+1)
+}
+
+// covered reports whether the ranges, each excluding its end, cover every
+// number from start up to but excluding end
+func covered(ranges [][2]int64, start, end int64) bool {
+	for start < end {
+		next := start
+		for _, r := range ranges {
+			if r[0] <= start && start < r[1] && r[1] > next {
+				next = r[1]
+			}
+		}
+		if next == start {
+			return false
+		}
+		start = next
+	}
+	return true
+}
+
+// marshalDescriptor encodes the descriptor as indented JSON. protojson
+// randomizes its whitespace, so the output is reformatted to keep the
+// checked-in file stable.
+func marshalDescriptor(file *descriptorpb.FileDescriptorProto) ([]byte, error) {
+	data, err := protojson.Marshal(file)
+	if err != nil {
+		return nil, err
+	}
+
+	compact := &bytes.Buffer{}
+	if err := json.Compact(compact, data); err != nil {
+		return nil, err
+	}
+
+	indented := &bytes.Buffer{}
+	if err := json.Indent(indented, compact.Bytes(), "", "  "); err != nil {
+		return nil, err
+	}
+	indented.WriteByte('\n')
+	return indented.Bytes(), nil
+}
+
+func TestCompatibilityChecks(t *testing.T) {
+	message := func(file *descriptorpb.FileDescriptorProto, name string) *descriptorpb.DescriptorProto {
+		for _, m := range file.GetMessageType() {
+			if m.GetName() == name {
+				return m
+			}
+		}
+		t.Fatalf("no message %s", name)
+		return nil
+	}
+	service := func(file *descriptorpb.FileDescriptorProto, name string) *descriptorpb.ServiceDescriptorProto {
+		for _, s := range file.GetService() {
+			if s.GetName() == name {
+				return s
+			}
+		}
+		t.Fatalf("no service %s", name)
+		return nil
+	}
+
+	tests := []struct {
+		name   string
+		change func(file *descriptorpb.FileDescriptorProto)
+		want   string
+	}{
+		{
+			name:   "unchanged",
+			change: func(file *descriptorpb.FileDescriptorProto) {},
+		},
+		{
+			name: "field renamed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				message(file, "ErrorDetail").GetField()[1].Name = proto.String("Text")
+			},
+		},
+		{
+			name: "field removed and reserved",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				m := message(file, "ErrorDetail")
+				m.Field = append(m.Field[:1], m.Field[2:]...)
+				m.ReservedRange = append(m.ReservedRange, &descriptorpb.DescriptorProto_ReservedRange{Start: proto.Int32(2), End: proto.Int32(3)})
+			},
+		},
+		{
+			name: "field removed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				m := message(file, "ErrorDetail")
+				m.Field = append(m.Field[:1], m.Field[2:]...)
+			},
+			want: "ErrorDetail.Message (2) was removed without reserving its number",
+		},
+		{
+			name: "field type changed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				message(file, "ErrorDetail").GetField()[1].Type = descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum()
+			},
+			want: "ErrorDetail.Message (2) changed type from TYPE_STRING to TYPE_INT64",
+		},
+		{
+			name: "reserved number reused",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				m := message(file, "ScheduleRequest")
+				m.ReservedRange = m.ReservedRange[:1]
+				m.Field = append(m.Field, &descriptorpb.FieldDescriptorProto{
+					Name:   proto.String("Retries"),
+					Number: proto.Int32(5),
+					Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:   descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum(),
+				})
+			},
+			want: "ScheduleRequest no longer reserves 5 to 5",
+		},
+		{
+			name: "method added at the end",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				s := service(file, "Inventory")
+				s.Method = append(s.Method, &descriptorpb.MethodDescriptorProto{
+					Name:       proto.String("Rename"),
+					InputType:  proto.String(".shared.v1.Noop"),
+					OutputType: proto.String(".shared.v1.Noop"),
+				})
+			},
+		},
+		{
+			name: "method inserted",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				s := service(file, "Inventory")
+				s.Method = append([]*descriptorpb.MethodDescriptorProto{{
+					Name:       proto.String("Rename"),
+					InputType:  proto.String(".shared.v1.Noop"),
+					OutputType: proto.String(".shared.v1.Noop"),
+				}}, s.Method...)
+			},
+			want: "Inventory.Describe (0) was replaced by Rename",
+		},
+		{
+			name: "methods swapped",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				s := service(file, "Inventory")
+				s.Method[0], s.Method[1] = s.Method[1], s.Method[0]
+			},
+			want: "Inventory.Describe (0) was replaced by StartBuild",
+		},
+		{
+			name: "method removed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				s := service(file, "Inventory")
+				s.Method = s.Method[:len(s.Method)-1]
+			},
+			want: "Inventory.Demolish (6) was removed",
+		},
+		{
+			name: "request changed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				service(file, "Inventory").GetMethod()[0].InputType = proto.String(".shared.v1.Noop")
+			},
+			want: "Inventory.Describe (0) changed its request from .shared.v1.DescribeInventoryRequest to .shared.v1.Noop",
+		},
+		{
+			name: "response changed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				service(file, "Timer").GetMethod()[0].OutputType = proto.String(".shared.v1.HelloResponse")
+			},
+			want: "Timer.Start (0) changed its response from .shared.v1.Noop to .shared.v1.HelloResponse",
+		},
+		{
+			name: "service removed",
+			change: func(file *descriptorpb.FileDescriptorProto) {
+				file.Service = file.GetService()[1:]
+			},
+			want: "service Hello was removed",
+		},
+	}
+
+	baseline := protodesc.ToFileDescriptorProto(File_v1_common_proto)
+	for _, tt := range tests {
+		current := proto.Clone(baseline).(*descriptorpb.FileDescriptorProto)
+		tt.change(current)
+
+		problems := compareFile(baseline, current)
+		if tt.want == "" {
+			if len(problems) > 0 {
+				t.Errorf("%s: want no problems, got %v", tt.name, problems)
+			}
+			continue
+		}
+		found := false
+		for _, problem := range problems {
+			found = found || problem == tt.want
+		}
+		if !found {
+			t.Errorf("%s: want %q, got %v", tt.name, tt.want, problems)
+		}
+	}
+}
+
+func TestReservedRanges(t *testing.T) {
+	message := &descriptorpb.DescriptorProto{ReservedRange: []*descriptorpb.DescriptorProto_ReservedRange{
+		{Start: proto.Int32(2), End: proto.Int32(5)},
+		{Start: proto.Int32(5), End: proto.Int32(7)},
+		{Start: proto.Int32(100), End: proto.Int32(536870912)},
+	}}
+	enum := &descriptorpb.EnumDescriptorProto{ReservedRange: []*descriptorpb.EnumDescriptorProto_EnumReservedRange{
+		{Start: proto.Int32(2), End: proto.Int32(4)},
+		{Start: proto.Int32(1000), End: proto.Int32(2147483647)},
+	}}
+
+	tests := []struct {
+		start, end int32
+		fields     bool
+		values     bool
+	}{
+		{2, 3, true, true},
+		{2, 5, true, true},
+		// split across two message ranges
+		{3, 7, true, false},
+		{6, 8, false, false},
+		{1, 2, false, false},
+		{100, 536870912, true, false},
+		{1000, 2147483647, false, true},
+	}
+
+	for _, tt := range tests {
+		if got := reservedFields(message, tt.start, tt.end); got != tt.fields {
+			t.Errorf("fields %d to %d: want %t, got %t", tt.start, tt.end, tt.fields, got)
+		}
+		if got := reservedValues(enum, tt.start, tt.end-1); got != tt.values {
+			t.Errorf("values %d to %d: want %t, got %t", tt.start, tt.end-1, tt.values, got)
+		}
+	}
+}
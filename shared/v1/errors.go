@@ -0,0 +1,6 @@
+package v1
+
+// Failure returns the detail of a refused request
+func Failure(code ErrorCode, message string) *ErrorDetail {
+	return &ErrorDetail{Code: code, Message: message}
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MethodIndex returns the index the generated grain code dispatches the
+// method of a kind on. Kinds are named after their services and methods are
+// numbered in the order the service declares them.
+func MethodIndex(kind, method string) (int32, error) {
+	service := File_v1_common_proto.Services().ByName(protoreflect.Name(kind))
+	if service == nil {
+		return 0, fmt.Errorf("unknown grain kind %s", kind)
+	}
+	m := service.Methods().ByName(protoreflect.Name(method))
+	if m == nil {
+		return 0, fmt.Errorf("%s has no method %s", kind, method)
+	}
+	return int32(m.Index()), nil
+}
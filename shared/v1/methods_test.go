@@ -0,0 +1,33 @@
+package v1
+
+import "testing"
+
+func TestMethodIndex(t *testing.T) {
+	tests := []struct {
+		kind   string
+		method string
+		index  int32
+		ok     bool
+	}{
+		{"Inventory", "Describe", 0, true},
+		{"Inventory", "StartBuild", 1, true},
+		{"Inventory", "Demolish", 6, true},
+		{"Timer", "Start", 0, true},
+		{"Inventory", "Start", 0, false},
+		{"Barracks", "Describe", 0, false},
+	}
+
+	for _, tt := range tests {
+		index, err := MethodIndex(tt.kind, tt.method)
+		if (err == nil) != tt.ok {
+			t.Errorf("%s.%s: want ok %t, got error %v", tt.kind, tt.method, tt.ok, err)
+			continue
+		}
+		if !tt.ok {
+			continue
+		}
+		if index != tt.index {
+			t.Errorf("%s.%s: want index %d, got %d", tt.kind, tt.method, tt.index, index)
+		}
+	}
+}
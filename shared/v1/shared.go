@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	inventoryNamespace uuid.UUID = uuid.NewSHA1(uuid.NameSpaceDNS, []byte("inventory"))
+	playerNamespace    uuid.UUID = uuid.NewSHA1(uuid.NameSpaceDNS, []byte("player"))
+)
+
+func GenerateInventoryGrainID(userID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(inventoryNamespace, []byte(userID.String()))
+}
+
+// InventoryTopic returns the pub/sub topic the inventory grain publishes its
+// changes to
+func InventoryTopic(inventoryID string) string {
+	return fmt.Sprintf("inventory/%s", inventoryID)
+}
+
+// GeneratePlayerID returns the ID of the player registered under the name.
+// Names are case insensitive, so the ID can be derived again at login.
+func GeneratePlayerID(name string) uuid.UUID {
+	return uuid.NewSHA1(playerNamespace, []byte(strings.ToLower(strings.TrimSpace(name))))
+}